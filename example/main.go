@@ -13,17 +13,24 @@ import (
 
 // Define a type that can have nullable fields, and
 // will be used as our database result.
+//
+// The null tags configure the fields when the JSON is decoded by null.Unmarshal,
+// and when null.ApplyTags is called before the rows are scanned.
+// Sqlite3 driver only accepts int64 on types implementing the driver.Valuer interface,
+// which is why Age uses the int64 valuer. The times are stored in TEXT columns,
+// so they are formatted according to their RFC3339 layout rather than by the driver,
+// and scanned as the time values of SQLite.
 type User struct {
 	ID                 null.UUID   `json:"id"`
 	FirstName          null.String `json:"firstName"`
 	MiddleName         null.String `json:"middleName"`
 	LastName           null.String `json:"lastName"`
-	Age                null.Int8   `json:"age"`
+	Age                null.Int8   `json:"age"                null:"valuer=int64"`
 	Email              null.String `json:"email"`
-	CreatedAt          null.Time   `json:"createdAt"`
+	CreatedAt          null.Time   `json:"createdAt"          null:"sqlite=text,value=layout"`
 	SomeOptionalID     null.UUID   `json:"someOptionalId"`
 	SomeOptionalNumber null.Int64  `json:"someOptionalNumber"`
-	SomeOptionalTime   null.Time   `json:"someOptionalTime"`
+	SomeOptionalTime   null.Time   `json:"someOptionalTime"   null:"sqlite=text,value=layout"`
 }
 
 var (
@@ -56,9 +63,6 @@ func main() {
 }`
 
 	user, err := convertToUserModel(data)
-	// Sqlite3 driver only accepts int64 on types implementing the driver.Valuer interface.
-	// Which is why we are choosing WithInt64Valuer option to convert the value to int64.
-	user.Age = null.Int8From(user.Age.ValueOrZero(), null.WithInt64Valuer())
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -79,7 +83,7 @@ func main() {
 }
 
 func convertToUserModel(data string) (user User, err error) {
-	return user, json.Unmarshal([]byte(data), &user)
+	return user, null.Unmarshal([]byte(data), &user)
 }
 
 func createUser(user User) (err error) {
@@ -144,6 +148,11 @@ func getUserFromDB() (user User, err error) {
 		log.Fatal(err)
 	}
 
+	// Apply the null tags before scanning, so that the times are scanned as the time values of SQLite.
+	if err = null.ApplyTags(&user); err != nil {
+		return user, err
+	}
+
 	for {
 		ok := rows.Next()
 
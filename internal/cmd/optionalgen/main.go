@@ -0,0 +1,225 @@
+// Command optionalgen generates the Optional variants of the nullable types of the null package,
+// such as OptionalBool and OptionalTime, which embed their nullable type and record whether they were set.
+// It is run by go generate in the null package.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"go/format"
+	"log"
+	"os"
+	"text/template"
+)
+
+// optionalType describes a nullable type of the null package to generate an Optional variant of.
+type optionalType struct {
+	// Name is the name of the nullable type, such as Bool.
+	Name string
+
+	// Value is the type of the value of the nullable type, such as bool.
+	Value string
+
+	// Zero is the expression of the zero value of the value, such as ZeroBool.
+	Zero string
+
+	// Article is the indefinite article of the name in the doc comments, a or an.
+	Article string
+}
+
+var optionalTypes = []optionalType{
+	{Name: "Bool", Value: "bool", Zero: "ZeroBool", Article: "a"},
+	{Name: "Byte", Value: "byte", Zero: "ZeroByte", Article: "a"},
+	{Name: "Bytes", Value: "[]byte", Zero: "ZeroBytes", Article: "a"},
+	{Name: "Float32", Value: "float32", Zero: "ZeroFloat32", Article: "a"},
+	{Name: "Float64", Value: "float64", Zero: "ZeroFloat64", Article: "a"},
+	{Name: "Int", Value: "int", Zero: "ZeroInt", Article: "an"},
+	{Name: "Int8", Value: "int8", Zero: "ZeroInt8", Article: "an"},
+	{Name: "Int16", Value: "int16", Zero: "ZeroInt16", Article: "an"},
+	{Name: "Int32", Value: "int32", Zero: "ZeroInt32", Article: "an"},
+	{Name: "Int64", Value: "int64", Zero: "ZeroInt64", Article: "an"},
+	{Name: "JSON", Value: "[]byte", Zero: "ZeroBytes", Article: "a"},
+	{Name: "String", Value: "string", Zero: "ZeroString", Article: "a"},
+	{Name: "Time", Value: "time.Time", Zero: "ZeroTime", Article: "a"},
+	{Name: "Uint", Value: "uint", Zero: "ZeroUint", Article: "a"},
+	{Name: "Uint8", Value: "uint8", Zero: "ZeroUint8", Article: "a"},
+	{Name: "Uint16", Value: "uint16", Zero: "ZeroUint16", Article: "a"},
+	{Name: "Uint32", Value: "uint32", Zero: "ZeroUint32", Article: "a"},
+	{Name: "Uint64", Value: "uint64", Zero: "ZeroUint64", Article: "a"},
+	{Name: "UUID", Value: "uuid.UUID", Zero: "uuid.Nil", Article: "a"},
+}
+
+// optionalSource is the template of the generated file.
+const optionalSource = `// Code generated by optionalgen. DO NOT EDIT.
+
+package null // import "github.com/Patrick-Batenburg/nullify/null"
+
+import (
+	"encoding/xml"
+	"time"
+
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+)
+{{range .}}
+// Optional{{.Name}} is {{.Article}} {{.Name}} that also records whether it was set.
+// See Optional for the semantics of the absent, null and value states.
+type Optional{{.Name}} struct {
+	{{.Name}}
+
+	// set determines if the value was present in the decoded input.
+	set bool
+}
+
+// Optional{{.Name}}From creates a new Optional{{.Name}} that is set to the given {{.Name}}.
+func Optional{{.Name}}From(value {{.Name}}) Optional{{.Name}} {
+	return Optional{{.Name}}{
+		{{.Name}}: value,
+		set: true,
+	}
+}
+
+// IsSet returns true if the value was set, either to null or to a valid value.
+func (o Optional{{.Name}}) IsSet() bool {
+	return o.set
+}
+
+// IsNull returns true if the value was explicitly set to null.
+func (o Optional{{.Name}}) IsNull() bool {
+	return o.set && !o.IsValid()
+}
+
+// IsValue returns true if the value was set to a valid value.
+func (o Optional{{.Name}}) IsValue() bool {
+	return o.set && o.IsValid()
+}
+
+// IsZero returns true if the value is absent.
+// It is called by encoding/json's omitzero option, so absent values are
+// omitted while explicit nulls are kept.
+func (o Optional{{.Name}}) IsZero() bool {
+	return !o.set
+}
+
+// Scan implements the sql.Scanner interface.
+func (o *Optional{{.Name}}) Scan(src any) error {
+	o.set = true
+
+	return o.{{.Name}}.Scan(src)
+}
+
+// SetValue sets the value and marks it as set and valid.
+func (o *Optional{{.Name}}) SetValue(value {{.Value}}) {
+	o.set = true
+	o.{{.Name}}.SetValue(value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *Optional{{.Name}}) UnmarshalJSON(data []byte) error {
+	o.set = true
+
+	return o.{{.Name}}.UnmarshalJSON(data)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (o *Optional{{.Name}}) UnmarshalText(text []byte) error {
+	o.set = true
+
+	return o.{{.Name}}.UnmarshalText(text)
+}
+
+// Set implements the flag.Value interface and marks the value as set.
+func (o *Optional{{.Name}}) Set(value string) error {
+	o.set = true
+
+	return o.{{.Name}}.Set(value)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (o *Optional{{.Name}}) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	o.set = true
+
+	return o.{{.Name}}.UnmarshalXML(d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (o *Optional{{.Name}}) UnmarshalXMLAttr(attr xml.Attr) error {
+	o.set = true
+
+	return o.{{.Name}}.UnmarshalXMLAttr(attr)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// yaml.Unmarshal does not call it for null nodes, so an explicit null decodes as absent,
+// unless the YAML is decoded through the UnmarshalYAML function of this package.
+func (o *Optional{{.Name}}) UnmarshalYAML(node *yaml.Node) error {
+	o.set = true
+
+	return o.{{.Name}}.UnmarshalYAML(node)
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// Whether the value is set is encoded in the flags byte.
+func (o Optional{{.Name}}) AppendBinary(b []byte) ([]byte, error) {
+	start := len(b)
+	b, err := o.{{.Name}}.AppendBinary(b)
+
+	if err == nil && o.set {
+		b[start] |= binarySetFlag
+	}
+
+	return b, err
+}
+
+// GobEncode implements gob.GobEncoder.
+func (o Optional{{.Name}}) GobEncode() ([]byte, error) {
+	return o.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (o *Optional{{.Name}}) GobDecode(data []byte) error {
+	return o.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (o Optional{{.Name}}) MarshalBinary() ([]byte, error) {
+	return o.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (o *Optional{{.Name}}) UnmarshalBinary(data []byte) error {
+	o.set = len(data) > 0 && data[0]&binarySetFlag != 0
+
+	return o.{{.Name}}.UnmarshalBinary(data)
+}
+
+// Unset marks the value as absent and resets it to null.
+func (o *Optional{{.Name}}) Unset() {
+	o.value = {{.Zero}}
+	o.valid = false
+	o.set = false
+}
+{{end}}`
+
+var optionalTemplate = template.Must(template.New("optional").Parse(optionalSource))
+
+func main() {
+	output := flag.String("output", "optional_types.go", "the file to write the generated code to")
+	flag.Parse()
+
+	var buffer bytes.Buffer
+
+	if err := optionalTemplate.Execute(&buffer, optionalTypes); err != nil {
+		log.Fatal(err)
+	}
+
+	source, err := format.Source(buffer.Bytes())
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err = os.WriteFile(*output, source, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
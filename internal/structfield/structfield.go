@@ -0,0 +1,266 @@
+// Package structfield maps struct fields to named values and converts them from and to text.
+// It is shared by the packages that decode flat, textual formats into structs of nullable fields.
+package structfield // import "github.com/Patrick-Batenburg/nullify/internal/structfield"
+
+import (
+	"database/sql"
+	"encoding"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/Patrick-Batenburg/nullify/null"
+)
+
+// ErrUnsupportedType is the error that the errors given to DecodeText and EncodeText wrap,
+// such as nullcsv.ErrUnsupportedType, for fields of a type that cannot be converted from or to text.
+var ErrUnsupportedType = errors.New("unsupported field type")
+
+// Field maps a struct field to a name.
+type Field struct {
+	// Name is the name given by the struct tag or, if untagged, the field name.
+	Name string
+
+	// Tagged reports whether the name is given by the struct tag.
+	Tagged bool
+
+	// Options are the comma separated options following the name in the struct tag.
+	Options []string
+
+	// Index is the index sequence for reflect.Value.FieldByIndex.
+	Index []int
+
+	// Type is the type of the field.
+	Type reflect.Type
+
+	// Tag is the struct tag of the field.
+	Tag reflect.StructTag
+}
+
+// HasOption reports whether the struct tag of the field contains option.
+func (f Field) HasOption(option string) bool {
+	for _, item := range f.Options {
+		if item == option {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validator is implemented by all nullable types.
+type validator interface {
+	IsValid() bool
+}
+
+var (
+	textMarshalerType   = reflect.TypeFor[encoding.TextMarshaler]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+// Fields returns the fields of the struct type t, named by the struct tag with key tagName.
+// Unexported fields and fields tagged with "-" are skipped, and untagged embedded structs are flattened.
+func Fields(t reflect.Type, tagName string) []Field {
+	var fields []Field
+
+	for i := range t.NumField() {
+		structField := t.Field(i)
+
+		if !structField.IsExported() {
+			continue
+		}
+
+		tag := structField.Tag.Get(tagName)
+
+		if tag == "-" {
+			continue
+		}
+
+		name, options, _ := strings.Cut(tag, ",")
+
+		if structField.Anonymous && name == "" && isFlattened(structField.Type) {
+			for _, item := range Fields(structField.Type, tagName) {
+				item.Index = append([]int{i}, item.Index...)
+				fields = append(fields, item)
+			}
+
+			continue
+		}
+
+		field := Field{
+			Name:   name,
+			Tagged: name != "",
+			Index:  []int{i},
+			Type:   structField.Type,
+			Tag:    structField.Tag,
+		}
+
+		if name == "" {
+			field.Name = structField.Name
+		}
+
+		if options != "" {
+			field.Options = strings.Split(options, ",")
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields
+}
+
+// isFlattened reports whether the embedded type t is flattened instead of being a field.
+func isFlattened(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct &&
+		!t.Implements(textMarshalerType) &&
+		!reflect.PointerTo(t).Implements(textUnmarshalerType)
+}
+
+// SetNull sets the addressable value to null.
+// Nullable types are decoded from empty text, which keeps their configuration, such as the
+// layout of a null.Time, and marks optional types as set. Any other value is set to its zero value.
+func SetNull(value reflect.Value) error {
+	if value.Kind() != reflect.Pointer {
+		target := value.Addr().Interface()
+		unmarshaler, isUnmarshaler := target.(encoding.TextUnmarshaler)
+		_, isNullable := target.(validator)
+
+		if isUnmarshaler && isNullable {
+			return unmarshaler.UnmarshalText(nil)
+		}
+	}
+
+	value.SetZero()
+
+	return nil
+}
+
+// DecodeText decodes text into the addressable value through encoding.TextUnmarshaler,
+// or strconv for basic kinds. Nil pointers are allocated.
+// Text that a nullable type would decode as null, such as "" or "null", is scanned as a value
+// instead, so that the caller alone decides what text represents null.
+// Errors are returned as a null.UnmarshalError, which wraps unsupported for unsupported types.
+func DecodeText(value reflect.Value, text string, unsupported error) error {
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			value.Set(reflect.New(value.Type().Elem()))
+		}
+
+		value = value.Elem()
+	}
+
+	target := value.Addr().Interface()
+
+	if unmarshaler, ok := target.(encoding.TextUnmarshaler); ok {
+		if err := unmarshaler.UnmarshalText([]byte(text)); err != nil {
+			if errors.Is(err, null.ErrCannotUnmarshal) {
+				return err
+			}
+
+			return null.NewUnmarshalError(text, target, err)
+		}
+
+		nullable, isNullable := target.(validator)
+		scanner, isScanner := target.(sql.Scanner)
+
+		if isNullable && isScanner && !nullable.IsValid() {
+			if err := scanner.Scan(text); err != nil {
+				return null.NewUnmarshalError(text, target, err)
+			}
+		}
+
+		return nil
+	}
+
+	var err error
+
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(text)
+	case reflect.Slice:
+		if value.Type().Elem().Kind() != reflect.Uint8 {
+			err = unsupported
+
+			break
+		}
+
+		value.SetBytes([]byte(text))
+	case reflect.Bool:
+		var parsed bool
+		parsed, err = strconv.ParseBool(text)
+		value.SetBool(parsed)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var parsed int64
+		parsed, err = strconv.ParseInt(text, 10, value.Type().Bits())
+		value.SetInt(parsed)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var parsed uint64
+		parsed, err = strconv.ParseUint(text, 10, value.Type().Bits())
+		value.SetUint(parsed)
+	case reflect.Float32, reflect.Float64:
+		var parsed float64
+		parsed, err = strconv.ParseFloat(text, value.Type().Bits())
+		value.SetFloat(parsed)
+	default:
+		err = unsupported
+	}
+
+	if err != nil {
+		return null.NewUnmarshalError(text, target, err)
+	}
+
+	return nil
+}
+
+// EncodeText encodes value through encoding.TextMarshaler, or strconv for basic kinds.
+// It returns false if value is a nil pointer or an invalid nullable value.
+// Errors are returned as a null.MarshalError, which wraps unsupported for unsupported types.
+func EncodeText(value reflect.Value, unsupported error) (text string, valid bool, err error) {
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return "", false, nil
+		}
+
+		value = value.Elem()
+	}
+
+	source := value.Interface()
+
+	if nullable, ok := source.(validator); ok && !nullable.IsValid() {
+		return "", false, nil
+	}
+
+	if marshaler, ok := source.(encoding.TextMarshaler); ok {
+		data, err := marshaler.MarshalText()
+
+		if err != nil {
+			if errors.Is(err, null.ErrCannotMarshal) {
+				return "", false, err
+			}
+
+			return "", false, null.NewMarshalError(source, err)
+		}
+
+		return string(data), true, nil
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		return value.String(), true, nil
+	case reflect.Slice:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			return string(value.Bytes()), true, nil
+		}
+	case reflect.Bool:
+		return strconv.FormatBool(value.Bool()), true, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), true, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10), true, nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'f', -1, value.Type().Bits()), true, nil
+	}
+
+	return "", false, null.NewMarshalError(source, unsupported)
+}
@@ -0,0 +1,159 @@
+package null // import "github.com/Patrick-Batenburg/nullify/null"
+
+import (
+	"cmp"
+	"iter"
+	"math"
+	"slices"
+)
+
+// Sum returns the sum of the valid values, similar to SQL's SUM.
+// Invalid values are skipped, and the result is null if no value is valid.
+// Integer sums are overflow checked and return ErrValuerCheckerIntegerOverflow,
+// floating-point sums use Kahan summation to limit the loss of precision.
+func Sum[N Getter[T], T Number](values ...N) (NullableImpl[T], error) {
+	return SumSeq(slices.Values(values))
+}
+
+// SumSeq is like Sum but aggregates the values yielded by seq.
+func SumSeq[N Getter[T], T Number](seq iter.Seq[N]) (NullableImpl[T], error) {
+	if isFloat[T]() {
+		sum, count := kahanSum(seq)
+
+		return New(T(sum), count > 0), nil
+	}
+
+	var (
+		result NullableImpl[T]
+		err    error
+	)
+
+	for value := range Values(seq) {
+		if !result.IsValid() {
+			result = From(value)
+
+			continue
+		}
+
+		result, err = Add(result, From(value))
+
+		if err != nil {
+			return NullableImpl[T]{}, err
+		}
+	}
+
+	return result, nil
+}
+
+// Avg returns the arithmetic mean of the valid values, similar to SQL's AVG.
+// Invalid values are skipped, and the result is null if no value is valid.
+func Avg[N Getter[T], T Number](values ...N) NullableImpl[float64] {
+	return AvgSeq(slices.Values(values))
+}
+
+// AvgSeq is like Avg but aggregates the values yielded by seq.
+func AvgSeq[N Getter[T], T Number](seq iter.Seq[N]) NullableImpl[float64] {
+	sum, count := kahanSum(seq)
+
+	if count == 0 {
+		return NullableImpl[float64]{}
+	}
+
+	return From(sum / float64(count))
+}
+
+// Count returns the number of valid values, similar to SQL's COUNT(column).
+func Count[N Getter[T], T any](values ...N) int {
+	return CountSeq(slices.Values(values))
+}
+
+// CountSeq is like Count but counts the values yielded by seq.
+func CountSeq[N Getter[T], T any](seq iter.Seq[N]) int {
+	count := 0
+
+	for range Values(seq) {
+		count++
+	}
+
+	return count
+}
+
+// CountAll returns the number of values including invalid values, similar to SQL's COUNT(*).
+func CountAll[N Getter[T], T any](values ...N) int {
+	return len(values)
+}
+
+// CountAllSeq is like CountAll but counts the values yielded by seq.
+func CountAllSeq[N Getter[T], T any](seq iter.Seq[N]) int {
+	count := 0
+
+	for range seq {
+		count++
+	}
+
+	return count
+}
+
+// MinSeq is like Min but aggregates the values yielded by seq.
+func MinSeq[N Getter[T], T cmp.Ordered](seq iter.Seq[N]) NullableImpl[T] {
+	return minFuncSeq(cmp.Compare[T], seq)
+}
+
+// MaxSeq is like Max but aggregates the values yielded by seq.
+func MaxSeq[N Getter[T], T cmp.Ordered](seq iter.Seq[N]) NullableImpl[T] {
+	return maxFuncSeq(cmp.Compare[T], seq)
+}
+
+// StdDev returns the sample standard deviation of the valid values, similar to SQL's STDDEV.
+// Invalid values are skipped, and the result is null if fewer than two values are valid.
+func StdDev[N Getter[T], T Number](values ...N) NullableImpl[float64] {
+	return StdDevSeq(slices.Values(values))
+}
+
+// StdDevSeq is like StdDev but aggregates the values yielded by seq.
+func StdDevSeq[N Getter[T], T Number](seq iter.Seq[N]) NullableImpl[float64] {
+	// Welford's online algorithm avoids the catastrophic cancellation
+	// of the naive sum of squares approach.
+	var (
+		count int
+		mean  float64
+		m2    float64
+	)
+
+	for value := range Values(seq) {
+		count++
+		x := float64(value)
+		delta := x - mean
+		mean += delta / float64(count)
+		m2 += delta * (x - mean)
+	}
+
+	if count < 2 {
+		return NullableImpl[float64]{}
+	}
+
+	return From(math.Sqrt(m2 / float64(count-1)))
+}
+
+// kahanSum returns the compensated sum of the valid values yielded by seq as a float64
+// and the number of valid values. It uses the Kahan-Babuška (Neumaier) variant,
+// which also compensates when an addend is larger than the running sum.
+func kahanSum[N Getter[T], T Number](seq iter.Seq[N]) (sum float64, count int) {
+	var compensation float64
+
+	for value := range Values(seq) {
+		count++
+		x := float64(value)
+		t := sum + x
+
+		if math.Abs(sum) >= math.Abs(x) {
+			compensation += (sum - t) + x
+		} else {
+			compensation += (x - t) + sum
+		}
+
+		sum = t
+	}
+
+	return sum + compensation, count
+}
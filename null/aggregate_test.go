@@ -0,0 +1,128 @@
+package null
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSum(t *testing.T) {
+	values := []Int64{Int64From(1), NewInt64(100, false), Int64From(2), Int64From(3)}
+	result, err := Sum(values...)
+	require.NoError(t, err)
+	assert.Equal(t, From(int64(6)), result)
+
+	result, err = SumSeq(slices.Values(values))
+	require.NoError(t, err)
+	assert.Equal(t, From(int64(6)), result)
+
+	result, err = Sum(NewInt64(1, false), NewInt64(2, false))
+	require.NoError(t, err)
+	assert.False(t, result.IsValid())
+
+	result, err = Sum[Int64]()
+	require.NoError(t, err)
+	assert.False(t, result.IsValid())
+
+	_, err = Sum(Int8From(math.MaxInt8), Int8From(1))
+	require.ErrorIs(t, err, ErrValuerCheckerIntegerOverflow)
+}
+
+func TestSumKahan(t *testing.T) {
+	values := []Float64{Float64From(1)}
+
+	for range 10_000 {
+		values = append(values, Float64From(1e-16))
+	}
+
+	result, err := Sum(values...)
+	require.NoError(t, err)
+	assert.InDelta(t, 1+1e-12, result.MustValue(), 1e-15)
+
+	float32Result, err := Sum(Float32From(0.1), NewFloat32(1, false), Float32From(0.2))
+	require.NoError(t, err)
+	assert.Equal(t, From(float32(0.3)), float32Result)
+
+	null, err := Sum(NewFloat64(1, false))
+	require.NoError(t, err)
+	assert.False(t, null.IsValid())
+}
+
+func TestAvg(t *testing.T) {
+	assert.Equal(t, From(2.5), Avg(IntFrom(1), NewInt(100, false), IntFrom(4)))
+	maxInt64 := Int64From(math.MaxInt64)
+	assert.Equal(t, From(float64(math.MaxInt64)), Avg(maxInt64, maxInt64))
+	assert.False(t, Avg(NewInt(1, false)).IsValid())
+	assert.False(t, AvgSeq(slices.Values([]Float32{})).IsValid())
+}
+
+func TestCount(t *testing.T) {
+	values := []String{StringFrom("a"), NewString("b", false), StringFrom(ZeroString)}
+	assert.Equal(t, 2, Count(values...))
+	assert.Equal(t, 2, CountSeq(slices.Values(values)))
+	assert.Equal(t, 3, CountAll(values...))
+	assert.Equal(t, 3, CountAllSeq(slices.Values(values)))
+	assert.Equal(t, 0, Count(NewString("a", false)))
+	assert.Equal(t, 0, Count[String]())
+}
+
+func TestMinMaxSeq(t *testing.T) {
+	values := []Float64{NewFloat64(-10, false), Float64From(3), Float64From(1), Float64From(2)}
+	assert.Equal(t, From(1.0), MinSeq(slices.Values(values)))
+	assert.Equal(t, From(3.0), MaxSeq(slices.Values(values)))
+	assert.False(t, MinSeq(slices.Values([]Float64{NewFloat64(1, false)})).IsValid())
+	assert.False(t, MaxSeq(slices.Values([]Float64{})).IsValid())
+
+	// NaN is ordered before any other value, as by cmp.Compare.
+	withNaN := []Float64{Float64From(3), Float64From(math.NaN()), Float64From(1)}
+	assert.True(t, math.IsNaN(MinSeq(slices.Values(withNaN)).MustValue()))
+	assert.True(t, math.IsNaN(Min(withNaN...).MustValue()))
+	assert.InDelta(t, 3.0, MaxSeq(slices.Values(withNaN)).MustValue(), 0)
+	assert.InDelta(t, 3.0, Max(withNaN...).MustValue(), 0)
+
+	// The values are generated as they are consumed, and each is consumed once.
+	yielded := 0
+	stream := func(yield func(Int) bool) {
+		for i := range 100_000 {
+			yielded++
+
+			if !yield(IntFrom(i%1000 - 500)) {
+				return
+			}
+		}
+	}
+	assert.Equal(t, From(-500), MinSeq(stream))
+	assert.Equal(t, 100_000, yielded)
+	assert.Equal(t, From(499), MaxSeq(stream))
+	assert.Equal(t, 200_000, yielded)
+}
+
+func TestStdDev(t *testing.T) {
+	values := []Int{
+		IntFrom(2),
+		IntFrom(4),
+		IntFrom(4),
+		NewInt(100, false),
+		IntFrom(4),
+		IntFrom(5),
+		IntFrom(5),
+		IntFrom(7),
+		IntFrom(9),
+	}
+	assert.InDelta(t, 2.138089935299395, StdDev(values...).MustValue(), 1e-12)
+	assert.InDelta(t, 2.138089935299395, StdDevSeq(slices.Values(values)).MustValue(), 1e-12)
+
+	shifted := []Float64{
+		Float64From(1e9 + 4),
+		Float64From(1e9 + 7),
+		Float64From(1e9 + 13),
+		Float64From(1e9 + 16),
+	}
+	assert.InDelta(t, math.Sqrt(30), StdDev(shifted...).MustValue(), 1e-6)
+
+	assert.False(t, StdDev(IntFrom(1)).IsValid())
+	assert.False(t, StdDev(IntFrom(1), NewInt(2, false)).IsValid())
+}
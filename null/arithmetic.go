@@ -0,0 +1,195 @@
+package null // import "github.com/Patrick-Batenburg/nullify/null"
+
+import (
+	"math"
+)
+
+// Signed is a constraint that permits any signed integer type.
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Unsigned is a constraint that permits any unsigned integer type.
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// Integer is a constraint that permits any integer type.
+type Integer interface {
+	Signed | Unsigned
+}
+
+// Float is a constraint that permits any floating-point type.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	Integer | Float
+}
+
+// Add returns the sum of a and b.
+// The result is null if either operand is null, following SQL semantics.
+// An integer overflow returns ErrValuerCheckerIntegerOverflow.
+func Add[T Number](a NullableImpl[T], b NullableImpl[T]) (NullableImpl[T], error) {
+	if !a.IsValid() || !b.IsValid() {
+		return NullableImpl[T]{}, nil
+	}
+
+	x, y := a.value, b.value
+	result := x + y
+
+	if !isFloat[T]() && (y > 0) != (result > x) {
+		return NullableImpl[T]{}, NewArithmeticError(a, ErrValuerCheckerIntegerOverflow)
+	}
+
+	return From(result), nil
+}
+
+// Sub returns the difference of a and b.
+// The result is null if either operand is null, following SQL semantics.
+// An integer overflow returns ErrValuerCheckerIntegerOverflow.
+func Sub[T Number](a NullableImpl[T], b NullableImpl[T]) (NullableImpl[T], error) {
+	if !a.IsValid() || !b.IsValid() {
+		return NullableImpl[T]{}, nil
+	}
+
+	x, y := a.value, b.value
+	result := x - y
+
+	if !isFloat[T]() && (y > 0) != (result < x) {
+		return NullableImpl[T]{}, NewArithmeticError(a, ErrValuerCheckerIntegerOverflow)
+	}
+
+	return From(result), nil
+}
+
+// Mul returns the product of a and b.
+// The result is null if either operand is null, following SQL semantics.
+// An integer overflow returns ErrValuerCheckerIntegerOverflow.
+func Mul[T Number](a NullableImpl[T], b NullableImpl[T]) (NullableImpl[T], error) {
+	if !a.IsValid() || !b.IsValid() {
+		return NullableImpl[T]{}, nil
+	}
+
+	x, y := a.value, b.value
+	result := x * y
+
+	if !isFloat[T]() && x != 0 && (result/x != y || (isMinusOne(x) && y != 0 && result == y)) {
+		return NullableImpl[T]{}, NewArithmeticError(a, ErrValuerCheckerIntegerOverflow)
+	}
+
+	return From(result), nil
+}
+
+// Div returns the quotient of a and b. Integer division truncates towards zero.
+// The result is null if either operand is null, following SQL semantics.
+// Dividing by zero returns ErrArithmeticDivisionByZero, like SQL does.
+// Use NullIf on the divisor to get a null result instead, e.g. Div(a, NullIf(b, 0)).
+// An integer overflow returns ErrValuerCheckerIntegerOverflow.
+func Div[T Number](a NullableImpl[T], b NullableImpl[T]) (NullableImpl[T], error) {
+	if !a.IsValid() || !b.IsValid() {
+		return NullableImpl[T]{}, nil
+	}
+
+	x, y := a.value, b.value
+
+	if y == 0 {
+		return NullableImpl[T]{}, NewArithmeticError(a, ErrArithmeticDivisionByZero)
+	}
+
+	result := x / y
+
+	if !isFloat[T]() && isMinusOne(y) && x != 0 && result == x {
+		return NullableImpl[T]{}, NewArithmeticError(a, ErrValuerCheckerIntegerOverflow)
+	}
+
+	return From(result), nil
+}
+
+// Mod returns the remainder of a divided by b, with the sign of a.
+// The result is null if either operand is null, following SQL semantics.
+// Dividing by zero returns ErrArithmeticDivisionByZero, like SQL does.
+func Mod[T Number](a NullableImpl[T], b NullableImpl[T]) (NullableImpl[T], error) {
+	if !a.IsValid() || !b.IsValid() {
+		return NullableImpl[T]{}, nil
+	}
+
+	x, y := a.value, b.value
+
+	if y == 0 {
+		return NullableImpl[T]{}, NewArithmeticError(a, ErrArithmeticDivisionByZero)
+	}
+
+	if isFloat[T]() {
+		return From(T(math.Mod(float64(x), float64(y)))), nil
+	}
+
+	return From(modInteger(x, y)), nil
+}
+
+// Neg returns the negation of a.
+// The result is null if a is null, following SQL semantics.
+// Negating the minimum signed integer or a non-zero unsigned integer
+// returns ErrValuerCheckerIntegerOverflow.
+func Neg[T Number](a NullableImpl[T]) (NullableImpl[T], error) {
+	if !a.IsValid() {
+		return NullableImpl[T]{}, nil
+	}
+
+	x := a.value
+	result := -x
+
+	if !isFloat[T]() && x != 0 && (result == x || !isSigned[T]()) {
+		return NullableImpl[T]{}, NewArithmeticError(a, ErrValuerCheckerIntegerOverflow)
+	}
+
+	return From(result), nil
+}
+
+// Abs returns the absolute value of a.
+// The result is null if a is null, following SQL semantics.
+// The absolute value of the minimum signed integer returns ErrValuerCheckerIntegerOverflow.
+func Abs[T Number](a NullableImpl[T]) (NullableImpl[T], error) {
+	if !a.IsValid() {
+		return NullableImpl[T]{}, nil
+	}
+
+	if isFloat[T]() {
+		return From(T(math.Abs(float64(a.value)))), nil
+	}
+
+	if a.value < 0 {
+		return Neg(a)
+	}
+
+	return a, nil
+}
+
+// isFloat returns true if T is a floating-point type.
+func isFloat[T Number]() bool {
+	half := 0.5
+
+	return T(half) != 0
+}
+
+// isSigned returns true if T is a signed type.
+func isSigned[T Number]() bool {
+	var zero T
+
+	return zero-1 < zero
+}
+
+// isMinusOne returns true if T is a signed type and x is -1.
+// Multiplying or dividing the minimum signed integer by -1 wraps around to itself.
+func isMinusOne[T Number](x T) bool {
+	var zero, one T = 0, 1
+
+	return isSigned[T]() && x == zero-one
+}
+
+// modInteger returns the integer remainder of x divided by y.
+func modInteger[T Number](x T, y T) T {
+	return x - (x/y)*y
+}
@@ -0,0 +1,186 @@
+package null
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdd(t *testing.T) {
+	result, err := Add(From(int8(1)), From(int8(2)))
+	require.NoError(t, err)
+	assert.Equal(t, From(int8(3)), result)
+
+	result, err = Add(From(int8(1)), New(int8(2), false))
+	require.NoError(t, err)
+	assert.Equal(t, NullableImpl[int8]{}, result)
+
+	_, err = Add(From(int8(math.MaxInt8)), From(int8(1)))
+	require.ErrorIs(t, err, ErrValuerCheckerIntegerOverflow)
+	require.ErrorIs(t, err, ErrCannotCalculate)
+
+	_, err = Add(From(int8(math.MinInt8)), From(int8(-1)))
+	require.ErrorIs(t, err, ErrValuerCheckerIntegerOverflow)
+
+	_, err = Add(From(uint64(math.MaxUint64)), From(uint64(1)))
+	require.ErrorIs(t, err, ErrValuerCheckerIntegerOverflow)
+
+	floatResult, err := Add(From(math.MaxFloat64), From(math.MaxFloat64))
+	require.NoError(t, err)
+	assert.True(t, math.IsInf(floatResult.MustValue(), 1))
+}
+
+func TestSub(t *testing.T) {
+	result, err := Sub(From(int16(1)), From(int16(2)))
+	require.NoError(t, err)
+	assert.Equal(t, From(int16(-1)), result)
+
+	result, err = Sub(New(int16(1), false), From(int16(2)))
+	require.NoError(t, err)
+	assert.False(t, result.IsValid())
+
+	_, err = Sub(From(int16(math.MinInt16)), From(int16(1)))
+	require.ErrorIs(t, err, ErrValuerCheckerIntegerOverflow)
+
+	_, err = Sub(From(int16(math.MaxInt16)), From(int16(-1)))
+	require.ErrorIs(t, err, ErrValuerCheckerIntegerOverflow)
+
+	_, err = Sub(From(uint(1)), From(uint(2)))
+	require.ErrorIs(t, err, ErrValuerCheckerIntegerOverflow)
+}
+
+func TestMul(t *testing.T) {
+	result, err := Mul(From(int32(-3)), From(int32(4)))
+	require.NoError(t, err)
+	assert.Equal(t, From(int32(-12)), result)
+
+	result, err = Mul(From(int32(-1)), From(int32(0)))
+	require.NoError(t, err)
+	assert.Equal(t, From(int32(0)), result)
+
+	_, err = Mul(From(int32(math.MaxInt32)), From(int32(2)))
+	require.ErrorIs(t, err, ErrValuerCheckerIntegerOverflow)
+
+	_, err = Mul(From(int32(-1)), From(int32(math.MinInt32)))
+	require.ErrorIs(t, err, ErrValuerCheckerIntegerOverflow)
+
+	_, err = Mul(From(int32(math.MinInt32)), From(int32(-1)))
+	require.ErrorIs(t, err, ErrValuerCheckerIntegerOverflow)
+
+	_, err = Mul(From(uint8(16)), From(uint8(16)))
+	require.ErrorIs(t, err, ErrValuerCheckerIntegerOverflow)
+}
+
+func TestDiv(t *testing.T) {
+	result, err := Div(From(int64(-7)), From(int64(2)))
+	require.NoError(t, err)
+	assert.Equal(t, From(int64(-3)), result)
+
+	floatResult, err := Div(From(float32(7)), From(float32(2)))
+	require.NoError(t, err)
+	assert.Equal(t, From(float32(3.5)), floatResult)
+
+	_, err = Div(From(int64(1)), From(int64(0)))
+	require.ErrorIs(t, err, ErrArithmeticDivisionByZero)
+
+	_, err = Div(From(1.0), From(0.0))
+	require.ErrorIs(t, err, ErrArithmeticDivisionByZero)
+
+	result, err = Div(From(int64(1)), NullIf(int64(0), 0))
+	require.NoError(t, err)
+	assert.False(t, result.IsValid())
+
+	_, err = Div(From(int64(math.MinInt64)), From(int64(-1)))
+	require.ErrorIs(t, err, ErrValuerCheckerIntegerOverflow)
+}
+
+func TestMod(t *testing.T) {
+	result, err := Mod(From(-7), From(3))
+	require.NoError(t, err)
+	assert.Equal(t, From(-1), result)
+
+	result, err = Mod(From(math.MinInt), From(-1))
+	require.NoError(t, err)
+	assert.Equal(t, From(0), result)
+
+	floatResult, err := Mod(From(7.5), From(2.0))
+	require.NoError(t, err)
+	assert.Equal(t, From(1.5), floatResult)
+
+	_, err = Mod(From(uint16(1)), From(uint16(0)))
+	require.ErrorIs(t, err, ErrArithmeticDivisionByZero)
+
+	result, err = Mod(From(1), New(0, false))
+	require.NoError(t, err)
+	assert.False(t, result.IsValid())
+}
+
+func TestNegAbs(t *testing.T) {
+	result, err := Neg(From(int8(5)))
+	require.NoError(t, err)
+	assert.Equal(t, From(int8(-5)), result)
+
+	_, err = Neg(From(int8(math.MinInt8)))
+	require.ErrorIs(t, err, ErrValuerCheckerIntegerOverflow)
+
+	_, err = Neg(From(uint8(1)))
+	require.ErrorIs(t, err, ErrValuerCheckerIntegerOverflow)
+
+	unsignedResult, err := Neg(From(uint8(0)))
+	require.NoError(t, err)
+	assert.Equal(t, From(uint8(0)), unsignedResult)
+
+	result, err = Abs(From(int8(-5)))
+	require.NoError(t, err)
+	assert.Equal(t, From(int8(5)), result)
+
+	_, err = Abs(From(int8(math.MinInt8)))
+	require.ErrorIs(t, err, ErrValuerCheckerIntegerOverflow)
+
+	floatResult, err := Abs(From(-1.5))
+	require.NoError(t, err)
+	assert.Equal(t, From(1.5), floatResult)
+
+	result, err = Abs(New(int8(-5), false))
+	require.NoError(t, err)
+	assert.False(t, result.IsValid())
+}
+
+func TestIntegerArithmeticPreservesValuerType(t *testing.T) {
+	sum, err := Int64From(40, WithInt32Valuer()).Add(Int64From(2))
+	require.NoError(t, err)
+	assert.Equal(t, Int64From(42, WithInt32Valuer()), sum)
+
+	driverValue, err := sum.Value()
+	require.NoError(t, err)
+	assert.Equal(t, int32(42), driverValue)
+
+	null, err := Int64From(40, WithInt32Valuer()).Sub(NewInt64(2, false))
+	require.NoError(t, err)
+	assert.Equal(t, NewInt64(0, false, WithInt32Valuer()), null)
+
+	_, err = Int64From(math.MaxInt32, WithInt32Valuer()).Add(Int64From(1))
+	require.ErrorIs(t, err, ErrValuerCheckerIntegerOverflow)
+
+	_, err = Uint8From(math.MaxUint8).Add(Uint8From(1))
+	require.ErrorIs(t, err, ErrValuerCheckerIntegerOverflow)
+
+	product, err := Uint16From(3, WithUint64Valuer()).Mul(Uint16From(4))
+	require.NoError(t, err)
+	assert.Equal(t, Uint16From(12, WithUint64Valuer()), product)
+}
+
+func TestFloatArithmetic(t *testing.T) {
+	sum, err := Float64From(1.5).Add(Float64From(2))
+	require.NoError(t, err)
+	assert.Equal(t, Float64From(3.5), sum)
+
+	negative, err := Float32From(1.5).Neg()
+	require.NoError(t, err)
+	assert.Equal(t, Float32From(-1.5), negative)
+
+	_, err = Float32From(1.5).Div(Float32From(0))
+	require.ErrorIs(t, err, ErrArithmeticDivisionByZero)
+}
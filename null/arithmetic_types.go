@@ -0,0 +1,643 @@
+package null // import "github.com/Patrick-Batenburg/nullify/null"
+
+// Add returns the sum of n and other. See Add.
+func (n Int) Add(other Int) (Int, error) {
+	return n.fromArithmetic(Add(n.NullableImpl, other.NullableImpl))
+}
+
+// Sub returns the difference of n and other. See Sub.
+func (n Int) Sub(other Int) (Int, error) {
+	return n.fromArithmetic(Sub(n.NullableImpl, other.NullableImpl))
+}
+
+// Mul returns the product of n and other. See Mul.
+func (n Int) Mul(other Int) (Int, error) {
+	return n.fromArithmetic(Mul(n.NullableImpl, other.NullableImpl))
+}
+
+// Div returns the quotient of n and other. See Div.
+func (n Int) Div(other Int) (Int, error) {
+	return n.fromArithmetic(Div(n.NullableImpl, other.NullableImpl))
+}
+
+// Mod returns the remainder of n divided by other. See Mod.
+func (n Int) Mod(other Int) (Int, error) {
+	return n.fromArithmetic(Mod(n.NullableImpl, other.NullableImpl))
+}
+
+// Neg returns the negation of n. See Neg.
+func (n Int) Neg() (Int, error) {
+	return n.fromArithmetic(Neg(n.NullableImpl))
+}
+
+// Abs returns the absolute value of n. See Abs.
+func (n Int) Abs() (Int, error) {
+	return n.fromArithmetic(Abs(n.NullableImpl))
+}
+
+// fromArithmetic wraps the result of an arithmetic operation and preserves valuerType.
+// The result is also checked against valuerType, so an overflow is reported
+// by the operation rather than by driver.Valuer.
+func (n Int) fromArithmetic(result NullableImpl[int], err error) (Int, error) {
+	if err == nil && result.IsValid() {
+		if _, valuerErr := integerValuerChecker(result.value, n.valuerType); valuerErr != nil {
+			err = NewArithmeticError(n, valuerErr)
+		}
+	}
+
+	if err != nil {
+		return Int{valuerType: n.valuerType}, err
+	}
+
+	return Int{
+		NullableImpl: result,
+		valuerType:   n.valuerType,
+	}, nil
+}
+
+// Add returns the sum of n and other. See Add.
+func (n Int8) Add(other Int8) (Int8, error) {
+	return n.fromArithmetic(Add(n.NullableImpl, other.NullableImpl))
+}
+
+// Sub returns the difference of n and other. See Sub.
+func (n Int8) Sub(other Int8) (Int8, error) {
+	return n.fromArithmetic(Sub(n.NullableImpl, other.NullableImpl))
+}
+
+// Mul returns the product of n and other. See Mul.
+func (n Int8) Mul(other Int8) (Int8, error) {
+	return n.fromArithmetic(Mul(n.NullableImpl, other.NullableImpl))
+}
+
+// Div returns the quotient of n and other. See Div.
+func (n Int8) Div(other Int8) (Int8, error) {
+	return n.fromArithmetic(Div(n.NullableImpl, other.NullableImpl))
+}
+
+// Mod returns the remainder of n divided by other. See Mod.
+func (n Int8) Mod(other Int8) (Int8, error) {
+	return n.fromArithmetic(Mod(n.NullableImpl, other.NullableImpl))
+}
+
+// Neg returns the negation of n. See Neg.
+func (n Int8) Neg() (Int8, error) {
+	return n.fromArithmetic(Neg(n.NullableImpl))
+}
+
+// Abs returns the absolute value of n. See Abs.
+func (n Int8) Abs() (Int8, error) {
+	return n.fromArithmetic(Abs(n.NullableImpl))
+}
+
+// fromArithmetic wraps the result of an arithmetic operation and preserves valuerType.
+// The result is also checked against valuerType, so an overflow is reported
+// by the operation rather than by driver.Valuer.
+func (n Int8) fromArithmetic(result NullableImpl[int8], err error) (Int8, error) {
+	if err == nil && result.IsValid() {
+		if _, valuerErr := integerValuerChecker(result.value, n.valuerType); valuerErr != nil {
+			err = NewArithmeticError(n, valuerErr)
+		}
+	}
+
+	if err != nil {
+		return Int8{valuerType: n.valuerType}, err
+	}
+
+	return Int8{
+		NullableImpl: result,
+		valuerType:   n.valuerType,
+	}, nil
+}
+
+// Add returns the sum of n and other. See Add.
+func (n Int16) Add(other Int16) (Int16, error) {
+	return n.fromArithmetic(Add(n.NullableImpl, other.NullableImpl))
+}
+
+// Sub returns the difference of n and other. See Sub.
+func (n Int16) Sub(other Int16) (Int16, error) {
+	return n.fromArithmetic(Sub(n.NullableImpl, other.NullableImpl))
+}
+
+// Mul returns the product of n and other. See Mul.
+func (n Int16) Mul(other Int16) (Int16, error) {
+	return n.fromArithmetic(Mul(n.NullableImpl, other.NullableImpl))
+}
+
+// Div returns the quotient of n and other. See Div.
+func (n Int16) Div(other Int16) (Int16, error) {
+	return n.fromArithmetic(Div(n.NullableImpl, other.NullableImpl))
+}
+
+// Mod returns the remainder of n divided by other. See Mod.
+func (n Int16) Mod(other Int16) (Int16, error) {
+	return n.fromArithmetic(Mod(n.NullableImpl, other.NullableImpl))
+}
+
+// Neg returns the negation of n. See Neg.
+func (n Int16) Neg() (Int16, error) {
+	return n.fromArithmetic(Neg(n.NullableImpl))
+}
+
+// Abs returns the absolute value of n. See Abs.
+func (n Int16) Abs() (Int16, error) {
+	return n.fromArithmetic(Abs(n.NullableImpl))
+}
+
+// fromArithmetic wraps the result of an arithmetic operation and preserves valuerType.
+// The result is also checked against valuerType, so an overflow is reported
+// by the operation rather than by driver.Valuer.
+func (n Int16) fromArithmetic(result NullableImpl[int16], err error) (Int16, error) {
+	if err == nil && result.IsValid() {
+		if _, valuerErr := integerValuerChecker(result.value, n.valuerType); valuerErr != nil {
+			err = NewArithmeticError(n, valuerErr)
+		}
+	}
+
+	if err != nil {
+		return Int16{valuerType: n.valuerType}, err
+	}
+
+	return Int16{
+		NullableImpl: result,
+		valuerType:   n.valuerType,
+	}, nil
+}
+
+// Add returns the sum of n and other. See Add.
+func (n Int32) Add(other Int32) (Int32, error) {
+	return n.fromArithmetic(Add(n.NullableImpl, other.NullableImpl))
+}
+
+// Sub returns the difference of n and other. See Sub.
+func (n Int32) Sub(other Int32) (Int32, error) {
+	return n.fromArithmetic(Sub(n.NullableImpl, other.NullableImpl))
+}
+
+// Mul returns the product of n and other. See Mul.
+func (n Int32) Mul(other Int32) (Int32, error) {
+	return n.fromArithmetic(Mul(n.NullableImpl, other.NullableImpl))
+}
+
+// Div returns the quotient of n and other. See Div.
+func (n Int32) Div(other Int32) (Int32, error) {
+	return n.fromArithmetic(Div(n.NullableImpl, other.NullableImpl))
+}
+
+// Mod returns the remainder of n divided by other. See Mod.
+func (n Int32) Mod(other Int32) (Int32, error) {
+	return n.fromArithmetic(Mod(n.NullableImpl, other.NullableImpl))
+}
+
+// Neg returns the negation of n. See Neg.
+func (n Int32) Neg() (Int32, error) {
+	return n.fromArithmetic(Neg(n.NullableImpl))
+}
+
+// Abs returns the absolute value of n. See Abs.
+func (n Int32) Abs() (Int32, error) {
+	return n.fromArithmetic(Abs(n.NullableImpl))
+}
+
+// fromArithmetic wraps the result of an arithmetic operation and preserves valuerType.
+// The result is also checked against valuerType, so an overflow is reported
+// by the operation rather than by driver.Valuer.
+func (n Int32) fromArithmetic(result NullableImpl[int32], err error) (Int32, error) {
+	if err == nil && result.IsValid() {
+		if _, valuerErr := integerValuerChecker(result.value, n.valuerType); valuerErr != nil {
+			err = NewArithmeticError(n, valuerErr)
+		}
+	}
+
+	if err != nil {
+		return Int32{valuerType: n.valuerType}, err
+	}
+
+	return Int32{
+		NullableImpl: result,
+		valuerType:   n.valuerType,
+	}, nil
+}
+
+// Add returns the sum of n and other. See Add.
+func (n Int64) Add(other Int64) (Int64, error) {
+	return n.fromArithmetic(Add(n.NullableImpl, other.NullableImpl))
+}
+
+// Sub returns the difference of n and other. See Sub.
+func (n Int64) Sub(other Int64) (Int64, error) {
+	return n.fromArithmetic(Sub(n.NullableImpl, other.NullableImpl))
+}
+
+// Mul returns the product of n and other. See Mul.
+func (n Int64) Mul(other Int64) (Int64, error) {
+	return n.fromArithmetic(Mul(n.NullableImpl, other.NullableImpl))
+}
+
+// Div returns the quotient of n and other. See Div.
+func (n Int64) Div(other Int64) (Int64, error) {
+	return n.fromArithmetic(Div(n.NullableImpl, other.NullableImpl))
+}
+
+// Mod returns the remainder of n divided by other. See Mod.
+func (n Int64) Mod(other Int64) (Int64, error) {
+	return n.fromArithmetic(Mod(n.NullableImpl, other.NullableImpl))
+}
+
+// Neg returns the negation of n. See Neg.
+func (n Int64) Neg() (Int64, error) {
+	return n.fromArithmetic(Neg(n.NullableImpl))
+}
+
+// Abs returns the absolute value of n. See Abs.
+func (n Int64) Abs() (Int64, error) {
+	return n.fromArithmetic(Abs(n.NullableImpl))
+}
+
+// fromArithmetic wraps the result of an arithmetic operation and preserves valuerType.
+// The result is also checked against valuerType, so an overflow is reported
+// by the operation rather than by driver.Valuer.
+func (n Int64) fromArithmetic(result NullableImpl[int64], err error) (Int64, error) {
+	if err == nil && result.IsValid() {
+		if _, valuerErr := integerValuerChecker(result.value, n.valuerType); valuerErr != nil {
+			err = NewArithmeticError(n, valuerErr)
+		}
+	}
+
+	if err != nil {
+		return Int64{valuerType: n.valuerType}, err
+	}
+
+	return Int64{
+		NullableImpl: result,
+		valuerType:   n.valuerType,
+	}, nil
+}
+
+// Add returns the sum of n and other. See Add.
+func (n Uint) Add(other Uint) (Uint, error) {
+	return n.fromArithmetic(Add(n.NullableImpl, other.NullableImpl))
+}
+
+// Sub returns the difference of n and other. See Sub.
+func (n Uint) Sub(other Uint) (Uint, error) {
+	return n.fromArithmetic(Sub(n.NullableImpl, other.NullableImpl))
+}
+
+// Mul returns the product of n and other. See Mul.
+func (n Uint) Mul(other Uint) (Uint, error) {
+	return n.fromArithmetic(Mul(n.NullableImpl, other.NullableImpl))
+}
+
+// Div returns the quotient of n and other. See Div.
+func (n Uint) Div(other Uint) (Uint, error) {
+	return n.fromArithmetic(Div(n.NullableImpl, other.NullableImpl))
+}
+
+// Mod returns the remainder of n divided by other. See Mod.
+func (n Uint) Mod(other Uint) (Uint, error) {
+	return n.fromArithmetic(Mod(n.NullableImpl, other.NullableImpl))
+}
+
+// Neg returns the negation of n. See Neg.
+func (n Uint) Neg() (Uint, error) {
+	return n.fromArithmetic(Neg(n.NullableImpl))
+}
+
+// Abs returns the absolute value of n. See Abs.
+func (n Uint) Abs() (Uint, error) {
+	return n.fromArithmetic(Abs(n.NullableImpl))
+}
+
+// fromArithmetic wraps the result of an arithmetic operation and preserves valuerType.
+// The result is also checked against valuerType, so an overflow is reported
+// by the operation rather than by driver.Valuer.
+func (n Uint) fromArithmetic(result NullableImpl[uint], err error) (Uint, error) {
+	if err == nil && result.IsValid() {
+		if _, valuerErr := integerValuerChecker(result.value, n.valuerType); valuerErr != nil {
+			err = NewArithmeticError(n, valuerErr)
+		}
+	}
+
+	if err != nil {
+		return Uint{valuerType: n.valuerType}, err
+	}
+
+	return Uint{
+		NullableImpl: result,
+		valuerType:   n.valuerType,
+	}, nil
+}
+
+// Add returns the sum of n and other. See Add.
+func (n Uint8) Add(other Uint8) (Uint8, error) {
+	return n.fromArithmetic(Add(n.NullableImpl, other.NullableImpl))
+}
+
+// Sub returns the difference of n and other. See Sub.
+func (n Uint8) Sub(other Uint8) (Uint8, error) {
+	return n.fromArithmetic(Sub(n.NullableImpl, other.NullableImpl))
+}
+
+// Mul returns the product of n and other. See Mul.
+func (n Uint8) Mul(other Uint8) (Uint8, error) {
+	return n.fromArithmetic(Mul(n.NullableImpl, other.NullableImpl))
+}
+
+// Div returns the quotient of n and other. See Div.
+func (n Uint8) Div(other Uint8) (Uint8, error) {
+	return n.fromArithmetic(Div(n.NullableImpl, other.NullableImpl))
+}
+
+// Mod returns the remainder of n divided by other. See Mod.
+func (n Uint8) Mod(other Uint8) (Uint8, error) {
+	return n.fromArithmetic(Mod(n.NullableImpl, other.NullableImpl))
+}
+
+// Neg returns the negation of n. See Neg.
+func (n Uint8) Neg() (Uint8, error) {
+	return n.fromArithmetic(Neg(n.NullableImpl))
+}
+
+// Abs returns the absolute value of n. See Abs.
+func (n Uint8) Abs() (Uint8, error) {
+	return n.fromArithmetic(Abs(n.NullableImpl))
+}
+
+// fromArithmetic wraps the result of an arithmetic operation and preserves valuerType.
+// The result is also checked against valuerType, so an overflow is reported
+// by the operation rather than by driver.Valuer.
+func (n Uint8) fromArithmetic(result NullableImpl[uint8], err error) (Uint8, error) {
+	if err == nil && result.IsValid() {
+		if _, valuerErr := integerValuerChecker(result.value, n.valuerType); valuerErr != nil {
+			err = NewArithmeticError(n, valuerErr)
+		}
+	}
+
+	if err != nil {
+		return Uint8{valuerType: n.valuerType}, err
+	}
+
+	return Uint8{
+		NullableImpl: result,
+		valuerType:   n.valuerType,
+	}, nil
+}
+
+// Add returns the sum of n and other. See Add.
+func (n Uint16) Add(other Uint16) (Uint16, error) {
+	return n.fromArithmetic(Add(n.NullableImpl, other.NullableImpl))
+}
+
+// Sub returns the difference of n and other. See Sub.
+func (n Uint16) Sub(other Uint16) (Uint16, error) {
+	return n.fromArithmetic(Sub(n.NullableImpl, other.NullableImpl))
+}
+
+// Mul returns the product of n and other. See Mul.
+func (n Uint16) Mul(other Uint16) (Uint16, error) {
+	return n.fromArithmetic(Mul(n.NullableImpl, other.NullableImpl))
+}
+
+// Div returns the quotient of n and other. See Div.
+func (n Uint16) Div(other Uint16) (Uint16, error) {
+	return n.fromArithmetic(Div(n.NullableImpl, other.NullableImpl))
+}
+
+// Mod returns the remainder of n divided by other. See Mod.
+func (n Uint16) Mod(other Uint16) (Uint16, error) {
+	return n.fromArithmetic(Mod(n.NullableImpl, other.NullableImpl))
+}
+
+// Neg returns the negation of n. See Neg.
+func (n Uint16) Neg() (Uint16, error) {
+	return n.fromArithmetic(Neg(n.NullableImpl))
+}
+
+// Abs returns the absolute value of n. See Abs.
+func (n Uint16) Abs() (Uint16, error) {
+	return n.fromArithmetic(Abs(n.NullableImpl))
+}
+
+// fromArithmetic wraps the result of an arithmetic operation and preserves valuerType.
+// The result is also checked against valuerType, so an overflow is reported
+// by the operation rather than by driver.Valuer.
+func (n Uint16) fromArithmetic(result NullableImpl[uint16], err error) (Uint16, error) {
+	if err == nil && result.IsValid() {
+		if _, valuerErr := integerValuerChecker(result.value, n.valuerType); valuerErr != nil {
+			err = NewArithmeticError(n, valuerErr)
+		}
+	}
+
+	if err != nil {
+		return Uint16{valuerType: n.valuerType}, err
+	}
+
+	return Uint16{
+		NullableImpl: result,
+		valuerType:   n.valuerType,
+	}, nil
+}
+
+// Add returns the sum of n and other. See Add.
+func (n Uint32) Add(other Uint32) (Uint32, error) {
+	return n.fromArithmetic(Add(n.NullableImpl, other.NullableImpl))
+}
+
+// Sub returns the difference of n and other. See Sub.
+func (n Uint32) Sub(other Uint32) (Uint32, error) {
+	return n.fromArithmetic(Sub(n.NullableImpl, other.NullableImpl))
+}
+
+// Mul returns the product of n and other. See Mul.
+func (n Uint32) Mul(other Uint32) (Uint32, error) {
+	return n.fromArithmetic(Mul(n.NullableImpl, other.NullableImpl))
+}
+
+// Div returns the quotient of n and other. See Div.
+func (n Uint32) Div(other Uint32) (Uint32, error) {
+	return n.fromArithmetic(Div(n.NullableImpl, other.NullableImpl))
+}
+
+// Mod returns the remainder of n divided by other. See Mod.
+func (n Uint32) Mod(other Uint32) (Uint32, error) {
+	return n.fromArithmetic(Mod(n.NullableImpl, other.NullableImpl))
+}
+
+// Neg returns the negation of n. See Neg.
+func (n Uint32) Neg() (Uint32, error) {
+	return n.fromArithmetic(Neg(n.NullableImpl))
+}
+
+// Abs returns the absolute value of n. See Abs.
+func (n Uint32) Abs() (Uint32, error) {
+	return n.fromArithmetic(Abs(n.NullableImpl))
+}
+
+// fromArithmetic wraps the result of an arithmetic operation and preserves valuerType.
+// The result is also checked against valuerType, so an overflow is reported
+// by the operation rather than by driver.Valuer.
+func (n Uint32) fromArithmetic(result NullableImpl[uint32], err error) (Uint32, error) {
+	if err == nil && result.IsValid() {
+		if _, valuerErr := integerValuerChecker(result.value, n.valuerType); valuerErr != nil {
+			err = NewArithmeticError(n, valuerErr)
+		}
+	}
+
+	if err != nil {
+		return Uint32{valuerType: n.valuerType}, err
+	}
+
+	return Uint32{
+		NullableImpl: result,
+		valuerType:   n.valuerType,
+	}, nil
+}
+
+// Add returns the sum of n and other. See Add.
+func (n Uint64) Add(other Uint64) (Uint64, error) {
+	return n.fromArithmetic(Add(n.NullableImpl, other.NullableImpl))
+}
+
+// Sub returns the difference of n and other. See Sub.
+func (n Uint64) Sub(other Uint64) (Uint64, error) {
+	return n.fromArithmetic(Sub(n.NullableImpl, other.NullableImpl))
+}
+
+// Mul returns the product of n and other. See Mul.
+func (n Uint64) Mul(other Uint64) (Uint64, error) {
+	return n.fromArithmetic(Mul(n.NullableImpl, other.NullableImpl))
+}
+
+// Div returns the quotient of n and other. See Div.
+func (n Uint64) Div(other Uint64) (Uint64, error) {
+	return n.fromArithmetic(Div(n.NullableImpl, other.NullableImpl))
+}
+
+// Mod returns the remainder of n divided by other. See Mod.
+func (n Uint64) Mod(other Uint64) (Uint64, error) {
+	return n.fromArithmetic(Mod(n.NullableImpl, other.NullableImpl))
+}
+
+// Neg returns the negation of n. See Neg.
+func (n Uint64) Neg() (Uint64, error) {
+	return n.fromArithmetic(Neg(n.NullableImpl))
+}
+
+// Abs returns the absolute value of n. See Abs.
+func (n Uint64) Abs() (Uint64, error) {
+	return n.fromArithmetic(Abs(n.NullableImpl))
+}
+
+// fromArithmetic wraps the result of an arithmetic operation and preserves valuerType.
+// The result is also checked against valuerType, so an overflow is reported
+// by the operation rather than by driver.Valuer.
+func (n Uint64) fromArithmetic(result NullableImpl[uint64], err error) (Uint64, error) {
+	if err == nil && result.IsValid() {
+		if _, valuerErr := integerValuerChecker(result.value, n.valuerType); valuerErr != nil {
+			err = NewArithmeticError(n, valuerErr)
+		}
+	}
+
+	if err != nil {
+		return Uint64{valuerType: n.valuerType}, err
+	}
+
+	return Uint64{
+		NullableImpl: result,
+		valuerType:   n.valuerType,
+	}, nil
+}
+
+// Add returns the sum of n and other. See Add.
+func (n Float32) Add(other Float32) (Float32, error) {
+	return n.fromArithmetic(Add(n.NullableImpl, other.NullableImpl))
+}
+
+// Sub returns the difference of n and other. See Sub.
+func (n Float32) Sub(other Float32) (Float32, error) {
+	return n.fromArithmetic(Sub(n.NullableImpl, other.NullableImpl))
+}
+
+// Mul returns the product of n and other. See Mul.
+func (n Float32) Mul(other Float32) (Float32, error) {
+	return n.fromArithmetic(Mul(n.NullableImpl, other.NullableImpl))
+}
+
+// Div returns the quotient of n and other. See Div.
+func (n Float32) Div(other Float32) (Float32, error) {
+	return n.fromArithmetic(Div(n.NullableImpl, other.NullableImpl))
+}
+
+// Mod returns the remainder of n divided by other. See Mod.
+func (n Float32) Mod(other Float32) (Float32, error) {
+	return n.fromArithmetic(Mod(n.NullableImpl, other.NullableImpl))
+}
+
+// Neg returns the negation of n. See Neg.
+func (n Float32) Neg() (Float32, error) {
+	return n.fromArithmetic(Neg(n.NullableImpl))
+}
+
+// Abs returns the absolute value of n. See Abs.
+func (n Float32) Abs() (Float32, error) {
+	return n.fromArithmetic(Abs(n.NullableImpl))
+}
+
+// fromArithmetic wraps the result of an arithmetic operation.
+func (n Float32) fromArithmetic(result NullableImpl[float32], err error) (Float32, error) {
+	if err != nil {
+		return Float32{}, err
+	}
+
+	return Float32{
+		NullableImpl: result,
+	}, nil
+}
+
+// Add returns the sum of n and other. See Add.
+func (n Float64) Add(other Float64) (Float64, error) {
+	return n.fromArithmetic(Add(n.NullableImpl, other.NullableImpl))
+}
+
+// Sub returns the difference of n and other. See Sub.
+func (n Float64) Sub(other Float64) (Float64, error) {
+	return n.fromArithmetic(Sub(n.NullableImpl, other.NullableImpl))
+}
+
+// Mul returns the product of n and other. See Mul.
+func (n Float64) Mul(other Float64) (Float64, error) {
+	return n.fromArithmetic(Mul(n.NullableImpl, other.NullableImpl))
+}
+
+// Div returns the quotient of n and other. See Div.
+func (n Float64) Div(other Float64) (Float64, error) {
+	return n.fromArithmetic(Div(n.NullableImpl, other.NullableImpl))
+}
+
+// Mod returns the remainder of n divided by other. See Mod.
+func (n Float64) Mod(other Float64) (Float64, error) {
+	return n.fromArithmetic(Mod(n.NullableImpl, other.NullableImpl))
+}
+
+// Neg returns the negation of n. See Neg.
+func (n Float64) Neg() (Float64, error) {
+	return n.fromArithmetic(Neg(n.NullableImpl))
+}
+
+// Abs returns the absolute value of n. See Abs.
+func (n Float64) Abs() (Float64, error) {
+	return n.fromArithmetic(Abs(n.NullableImpl))
+}
+
+// fromArithmetic wraps the result of an arithmetic operation.
+func (n Float64) fromArithmetic(result NullableImpl[float64], err error) (Float64, error) {
+	if err != nil {
+		return Float64{}, err
+	}
+
+	return Float64{
+		NullableImpl: result,
+	}, nil
+}
@@ -0,0 +1,385 @@
+package null // import "github.com/Patrick-Batenburg/nullify/null"
+
+import (
+	"encoding"
+	"encoding/binary"
+	"encoding/json"
+	"math"
+)
+
+// The binary wire layout starts with a flags byte, followed by type specific
+// configuration and the encoded value if valid:
+//
+//	NullableImpl[T]: flags, value
+//	Int, Uint, ...:  flags, valuer type, value
+//	Bytes:           flags, encoding, value
+//	String:          flags, empty as null, trim space, white space as null, truncate, case,
+//	                 maximum length (varint), value
+//	Time:            flags, strict layout, layout length (uvarint), layout,
+//	                 JSON, text, scan and value epochs, value layout, value location,
+//	                 location, truncation (varint), SQLite scan, SQLite value, value
+//
+// Integers are encoded as (u)varints, floats as little-endian IEEE 754 bits,
+// strings and byte slices as their remaining raw bytes, and types implementing
+// encoding.BinaryMarshaler (such as time.Time and uuid.UUID) through that interface.
+// Any other type falls back to JSON.
+const (
+	// binaryValidFlag is set in the flags byte if the value is valid.
+	binaryValidFlag byte = 1 << iota
+
+	// binarySetFlag is set in the flags byte if an Optional value is set.
+	binarySetFlag
+)
+
+// binaryValuerTypes lists the integer valuer types in the order of their wire value.
+// The first entry is reserved for a nil valuer type.
+var binaryValuerTypes = []any{
+	nil,
+	ZeroInt,
+	ZeroInt8,
+	ZeroInt16,
+	ZeroInt32,
+	ZeroInt64,
+	ZeroUint,
+	ZeroUint8,
+	ZeroUint16,
+	ZeroUint32,
+	ZeroUint64,
+}
+
+// AppendBinary appends the binary representation of the value to b.
+// It implements the encoding.BinaryAppender interface.
+func (n NullableImpl[T]) AppendBinary(b []byte) ([]byte, error) {
+	b = appendBinaryFlags(b, n.IsValid())
+
+	if !n.IsValid() {
+		return b, nil
+	}
+
+	b, err := appendBinaryValue(b, n.value)
+
+	if err != nil {
+		return b, NewMarshalError(n, err)
+	}
+
+	return b, nil
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+func (n NullableImpl[T]) GobEncode() ([]byte, error) {
+	return n.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (n *NullableImpl[T]) GobDecode(data []byte) error {
+	return n.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (n NullableImpl[T]) MarshalBinary() ([]byte, error) {
+	return n.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (n *NullableImpl[T]) UnmarshalBinary(data []byte) error {
+	valid, data, err := readBinaryFlags(data)
+
+	if err != nil {
+		return NewUnmarshalError(data, n, err)
+	}
+
+	return n.unmarshalBinaryValue(valid, data)
+}
+
+// unmarshalBinaryValue decodes the value part of the wire layout.
+func (n *NullableImpl[T]) unmarshalBinaryValue(valid bool, data []byte) error {
+	var zero T
+	n.value = zero
+	n.valid = false
+
+	if !valid {
+		return nil
+	}
+
+	if err := readBinaryValue(data, &n.value); err != nil {
+		return NewUnmarshalError(data, n, err)
+	}
+
+	n.valid = true
+
+	return nil
+}
+
+// appendIntegerBinary appends the binary representation of an integer value and its valuer type to b.
+func appendIntegerBinary[T Integer](b []byte, n NullableImpl[T], valuerType any) ([]byte, error) {
+	b = appendBinaryFlags(b, n.IsValid())
+	b = append(b, binaryValuerTypeIndex(valuerType))
+
+	if !n.IsValid() {
+		return b, nil
+	}
+
+	return appendBinaryValue(b, n.value)
+}
+
+// unmarshalIntegerBinary decodes the binary representation of an integer value and returns its valuer type.
+func unmarshalIntegerBinary[T Integer](
+	data []byte,
+	n *NullableImpl[T],
+) (valuerType any, err error) {
+	valid, data, err := readBinaryFlags(data)
+
+	if err != nil {
+		return nil, NewUnmarshalError(data, n, err)
+	}
+
+	if len(data) == 0 || int(data[0]) >= len(binaryValuerTypes) {
+		return nil, NewUnmarshalError(data, n, ErrInvalidBinaryData)
+	}
+
+	return binaryValuerTypes[data[0]], n.unmarshalBinaryValue(valid, data[1:])
+}
+
+// binaryValuerTypeIndex returns the wire value of valuerType.
+// Valuer types that integerValuerChecker does not convert are encoded as nil.
+func binaryValuerTypeIndex(valuerType any) byte {
+	for i, item := range binaryValuerTypes[1:] {
+		if item == valuerType {
+			return byte(i + 1)
+		}
+	}
+
+	return 0
+}
+
+// appendBinaryFlags appends the flags byte to b.
+func appendBinaryFlags(b []byte, valid bool) []byte {
+	if valid {
+		return append(b, binaryValidFlag)
+	}
+
+	return append(b, 0)
+}
+
+// readBinaryFlags reads the flags byte and returns the validity and the remaining data.
+func readBinaryFlags(data []byte) (valid bool, rest []byte, err error) {
+	if len(data) == 0 {
+		return false, data, ErrInvalidBinaryData
+	}
+
+	return data[0]&binaryValidFlag != 0, data[1:], nil
+}
+
+// appendBinaryValue appends the binary representation of value to b.
+func appendBinaryValue(b []byte, value any) ([]byte, error) {
+	switch v := value.(type) {
+	case bool:
+		if v {
+			return append(b, 1), nil
+		}
+
+		return append(b, 0), nil
+	case int:
+		return binary.AppendVarint(b, int64(v)), nil
+	case int8:
+		return binary.AppendVarint(b, int64(v)), nil
+	case int16:
+		return binary.AppendVarint(b, int64(v)), nil
+	case int32:
+		return binary.AppendVarint(b, int64(v)), nil
+	case int64:
+		return binary.AppendVarint(b, v), nil
+	case uint:
+		return binary.AppendUvarint(b, uint64(v)), nil
+	case uint8:
+		return binary.AppendUvarint(b, uint64(v)), nil
+	case uint16:
+		return binary.AppendUvarint(b, uint64(v)), nil
+	case uint32:
+		return binary.AppendUvarint(b, uint64(v)), nil
+	case uint64:
+		return binary.AppendUvarint(b, v), nil
+	case float32:
+		return binary.LittleEndian.AppendUint32(b, math.Float32bits(v)), nil
+	case float64:
+		return binary.LittleEndian.AppendUint64(b, math.Float64bits(v)), nil
+	case string:
+		return append(b, v...), nil
+	case []byte:
+		return append(b, v...), nil
+	case encoding.BinaryMarshaler:
+		data, err := v.MarshalBinary()
+
+		return append(b, data...), err
+	default:
+		data, err := json.Marshal(v)
+
+		return append(b, data...), err
+	}
+}
+
+// binaryReader reads the configuration that precedes the value in the wire layout.
+// It records the first error, after which every read returns the zero value.
+type binaryReader struct {
+	data []byte
+	err  error
+}
+
+// readByte reads a byte that must not exceed limit.
+func (r *binaryReader) readByte(limit byte) byte {
+	if r.err != nil || len(r.data) == 0 || r.data[0] > limit {
+		r.err = ErrInvalidBinaryData
+
+		return 0
+	}
+
+	value := r.data[0]
+	r.data = r.data[1:]
+
+	return value
+}
+
+// readBool reads a bool encoded as a single byte.
+func (r *binaryReader) readBool() bool {
+	return r.readByte(1) == 1
+}
+
+// readString reads a string prefixed by its length as an uvarint.
+func (r *binaryReader) readString() string {
+	if r.err != nil {
+		return ZeroString
+	}
+
+	length, size := binary.Uvarint(r.data)
+
+	if size <= 0 || uint64(len(r.data)-size) < length {
+		r.err = ErrInvalidBinaryData
+
+		return ZeroString
+	}
+
+	value := string(r.data[size : size+int(length)])
+	r.data = r.data[size+int(length):]
+
+	return value
+}
+
+// readVarint reads a varint.
+func (r *binaryReader) readVarint() int64 {
+	if r.err != nil {
+		return 0
+	}
+
+	value, size := binary.Varint(r.data)
+
+	if size <= 0 {
+		r.err = ErrInvalidBinaryData
+
+		return 0
+	}
+
+	r.data = r.data[size:]
+
+	return value
+}
+
+// appendBinaryBool appends value encoded as a single byte to b.
+func appendBinaryBool(b []byte, value bool) []byte {
+	if value {
+		return append(b, 1)
+	}
+
+	return append(b, 0)
+}
+
+// appendBinaryString appends value prefixed by its length as an uvarint to b.
+func appendBinaryString(b []byte, value string) []byte {
+	b = binary.AppendUvarint(b, uint64(len(value)))
+
+	return append(b, value...)
+}
+
+// readBinaryValue decodes data into the value pointed to by dest.
+// The whole of data must be consumed.
+func readBinaryValue(data []byte, dest any) (err error) {
+	switch d := dest.(type) {
+	case *bool:
+		if len(data) != 1 || data[0] > 1 {
+			return ErrInvalidBinaryData
+		}
+
+		*d = data[0] == 1
+	case *int:
+		*d, err = readBinaryVarint[int](data)
+	case *int8:
+		*d, err = readBinaryVarint[int8](data)
+	case *int16:
+		*d, err = readBinaryVarint[int16](data)
+	case *int32:
+		*d, err = readBinaryVarint[int32](data)
+	case *int64:
+		*d, err = readBinaryVarint[int64](data)
+	case *uint:
+		*d, err = readBinaryUvarint[uint](data)
+	case *uint8:
+		*d, err = readBinaryUvarint[uint8](data)
+	case *uint16:
+		*d, err = readBinaryUvarint[uint16](data)
+	case *uint32:
+		*d, err = readBinaryUvarint[uint32](data)
+	case *uint64:
+		*d, err = readBinaryUvarint[uint64](data)
+	case *float32:
+		if len(data) != 4 {
+			return ErrInvalidBinaryData
+		}
+
+		*d = math.Float32frombits(binary.LittleEndian.Uint32(data))
+	case *float64:
+		if len(data) != 8 {
+			return ErrInvalidBinaryData
+		}
+
+		*d = math.Float64frombits(binary.LittleEndian.Uint64(data))
+	case *string:
+		*d = string(data)
+	case *[]byte:
+		*d = append([]byte{}, data...)
+	case encoding.BinaryUnmarshaler:
+		return d.UnmarshalBinary(data)
+	default:
+		return json.Unmarshal(data, dest)
+	}
+
+	return err
+}
+
+// readBinaryVarint decodes a varint that must consume the whole of data and fit into T.
+func readBinaryVarint[T Signed](data []byte) (T, error) {
+	value, size := binary.Varint(data)
+
+	if size <= 0 || size != len(data) {
+		return 0, ErrInvalidBinaryData
+	}
+
+	if int64(T(value)) != value {
+		return 0, ErrValuerCheckerIntegerOverflow
+	}
+
+	return T(value), nil
+}
+
+// readBinaryUvarint decodes an uvarint that must consume the whole of data and fit into T.
+func readBinaryUvarint[T Unsigned](data []byte) (T, error) {
+	value, size := binary.Uvarint(data)
+
+	if size <= 0 || size != len(data) {
+		return 0, ErrInvalidBinaryData
+	}
+
+	if uint64(T(value)) != value {
+		return 0, ErrValuerCheckerIntegerOverflow
+	}
+
+	return T(value), nil
+}
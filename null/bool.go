@@ -27,3 +27,79 @@ func BoolFromPtr(value *bool) Bool {
 		NullableImpl: FromPtr(value),
 	}
 }
+
+// And returns the logical conjunction of n and other using SQL three-valued logic.
+// A null value is treated as unknown: NULL AND false is false, NULL AND true is NULL.
+func (n Bool) And(other Bool) Bool {
+	switch {
+	case n.IsValid() && !n.value, other.IsValid() && !other.value:
+		return BoolFrom(false)
+	case n.IsValid() && other.IsValid():
+		return BoolFrom(true)
+	default:
+		return Bool{}
+	}
+}
+
+// Or returns the logical disjunction of n and other using SQL three-valued logic.
+// A null value is treated as unknown: NULL OR true is true, NULL OR false is NULL.
+func (n Bool) Or(other Bool) Bool {
+	switch {
+	case n.IsValid() && n.value, other.IsValid() && other.value:
+		return BoolFrom(true)
+	case n.IsValid() && other.IsValid():
+		return BoolFrom(false)
+	default:
+		return Bool{}
+	}
+}
+
+// Not returns the logical negation of n using SQL three-valued logic.
+// NOT NULL is NULL.
+func (n Bool) Not() Bool {
+	if !n.IsValid() {
+		return Bool{}
+	}
+
+	return BoolFrom(!n.value)
+}
+
+// Xor returns the exclusive disjunction of n and other using SQL three-valued logic.
+// The result is NULL if either value is null.
+func (n Bool) Xor(other Bool) Bool {
+	if !n.IsValid() || !other.IsValid() {
+		return Bool{}
+	}
+
+	return BoolFrom(n.value != other.value)
+}
+
+// Implies returns the material implication of n and other using SQL three-valued logic,
+// which is equivalent to (NOT n) OR other.
+func (n Bool) Implies(other Bool) Bool {
+	return n.Not().Or(other)
+}
+
+// AllOf returns the conjunction of all values using SQL three-valued logic.
+// It returns true if no values are given.
+func AllOf(values ...Bool) Bool {
+	result := BoolFrom(true)
+
+	for _, value := range values {
+		result = result.And(value)
+	}
+
+	return result
+}
+
+// AnyOf returns the disjunction of all values using SQL three-valued logic.
+// It returns false if no values are given.
+func AnyOf(values ...Bool) Bool {
+	result := BoolFrom(false)
+
+	for _, value := range values {
+		result = result.Or(value)
+	}
+
+	return result
+}
@@ -283,16 +283,24 @@ func TestBoolIsZero(t *testing.T) {
 	)
 
 	zero := NewBool(false, true)
-	assert.True(
+	assert.False(
 		t,
 		zero.IsZero(),
 	)
+	assert.True(
+		t,
+		zero.IsZeroValue(),
+	)
 
 	null := NewBool(false, false)
 	assert.True(
 		t,
 		null.IsZero(),
 	)
+	assert.True(
+		t,
+		null.IsNull(),
+	)
 }
 
 func TestBoolSetValue(t *testing.T) {
@@ -355,3 +363,89 @@ func TestBoolScan(t *testing.T) {
 		null,
 	)
 }
+
+// boolTruthTable lists the operands of every binary truth-table row in the
+// order true, false, null, matching Postgres' three-valued boolean logic.
+var boolTruthTable = []Bool{BoolFrom(true), BoolFrom(false), {}}
+
+func assertBoolTruthTable(t *testing.T, operator func(Bool, Bool) Bool, expected [3][3]Bool) {
+	t.Helper()
+
+	for i, a := range boolTruthTable {
+		for j, b := range boolTruthTable {
+			assert.Equal(t, expected[i][j], operator(a, b), "row %d column %d", i, j)
+		}
+	}
+}
+
+func TestBoolAnd(t *testing.T) {
+	assertBoolTruthTable(t, Bool.And, [3][3]Bool{
+		{BoolFrom(true), BoolFrom(false), {}},
+		{BoolFrom(false), BoolFrom(false), BoolFrom(false)},
+		{{}, BoolFrom(false), {}},
+	})
+}
+
+func TestBoolOr(t *testing.T) {
+	assertBoolTruthTable(t, Bool.Or, [3][3]Bool{
+		{BoolFrom(true), BoolFrom(true), BoolFrom(true)},
+		{BoolFrom(true), BoolFrom(false), {}},
+		{BoolFrom(true), {}, {}},
+	})
+}
+
+func TestBoolXor(t *testing.T) {
+	assertBoolTruthTable(t, Bool.Xor, [3][3]Bool{
+		{BoolFrom(false), BoolFrom(true), {}},
+		{BoolFrom(true), BoolFrom(false), {}},
+		{{}, {}, {}},
+	})
+}
+
+func TestBoolImplies(t *testing.T) {
+	assertBoolTruthTable(t, Bool.Implies, [3][3]Bool{
+		{BoolFrom(true), BoolFrom(false), {}},
+		{BoolFrom(true), BoolFrom(true), BoolFrom(true)},
+		{BoolFrom(true), {}, {}},
+	})
+}
+
+func TestBoolNot(t *testing.T) {
+	assert.Equal(t, BoolFrom(false), BoolFrom(true).Not())
+	assert.Equal(t, BoolFrom(true), BoolFrom(false).Not())
+	assert.Equal(t, Bool{}, Bool{}.Not())
+	assert.Equal(t, Bool{}, NewBool(true, false).Not())
+}
+
+func TestAllOf(t *testing.T) {
+	assert.Equal(t, BoolFrom(true), AllOf())
+	assert.Equal(t, BoolFrom(true), AllOf(BoolFrom(true), BoolFrom(true)))
+	assert.Equal(t, BoolFrom(false), AllOf(BoolFrom(true), Bool{}, BoolFrom(false)))
+	assert.Equal(t, Bool{}, AllOf(BoolFrom(true), Bool{}))
+}
+
+func TestAnyOf(t *testing.T) {
+	assert.Equal(t, BoolFrom(false), AnyOf())
+	assert.Equal(t, BoolFrom(false), AnyOf(BoolFrom(false), BoolFrom(false)))
+	assert.Equal(t, BoolFrom(true), AnyOf(BoolFrom(false), Bool{}, BoolFrom(true)))
+	assert.Equal(t, Bool{}, AnyOf(BoolFrom(false), Bool{}))
+}
+
+func TestBoolMarshalXML(t *testing.T) {
+	testData := newBoolData()
+	assertXMLRoundTrip(t, BoolFrom(testData.Value), testData.String, Bool{})
+	assertXMLRoundTrip(t, BoolFrom(ZeroBool), FalseString, Bool{})
+	assertXMLNull(t, Bool{}, Bool{})
+}
+
+func TestBoolMarshalYAML(t *testing.T) {
+	assertYAMLRoundTrip(t, BoolFrom(true), TrueString, Bool{})
+	assertYAMLRoundTrip(t, BoolFrom(ZeroBool), FalseString, Bool{})
+	assertYAMLNull(t, Bool{}, BoolFrom(true))
+}
+
+func TestBoolMarshalBinary(t *testing.T) {
+	assertBinaryRoundTrip(t, BoolFrom(true))
+	assertBinaryRoundTrip(t, BoolFrom(ZeroBool))
+	assertBinaryRoundTrip(t, Bool{})
+}
@@ -3,7 +3,12 @@ package null // import "github.com/Patrick-Batenburg/nullify/null"
 import (
 	"bytes"
 	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
 	"strconv"
+
+	"gopkg.in/yaml.v3"
 )
 
 // Byte is an NullableImpl byte.
@@ -40,7 +45,7 @@ func (n Byte) MarshalJSON() ([]byte, error) {
 		return NullStringBytes, nil
 	}
 
-	if n.IsZero() {
+	if n.IsZeroValue() {
 		return []byte(strconv.Quote(ZeroString)), nil
 	}
 
@@ -53,7 +58,7 @@ func (n Byte) MarshalText() ([]byte, error) {
 		return ZeroStringBytes, nil
 	}
 
-	if n.IsZero() {
+	if n.IsZeroValue() {
 		return []byte(ZeroString), nil
 	}
 
@@ -150,3 +155,74 @@ func (n *Byte) UnmarshalText(text []byte) error {
 
 	return nil
 }
+
+// MarshalXML implements xml.Marshaler.
+func (n Byte) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, n.IsValid(), n.currentConfig().XMLNull, n.MarshalText)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+func (n Byte) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, n.IsValid(), n.currentConfig().XMLNull, n.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (n *Byte) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, n.UnmarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (n *Byte) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, n.UnmarshalText)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (n Byte) MarshalYAML() (any, error) {
+	if !n.IsValid() {
+		return nil, nil
+	}
+
+	text, err := n.MarshalText()
+
+	return string(text), err
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (n *Byte) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAMLText(node, n, n.UnmarshalText)
+}
+
+// Set implements the flag.Value interface.
+func (n *Byte) Set(value string) error {
+	return setFlag(n, value)
+}
+
+// String implements the fmt.Stringer and flag.Value interfaces.
+func (n Byte) String() string {
+	if !n.IsValid() {
+		return n.currentConfig().NullText
+	}
+
+	return textString(n.MarshalText)
+}
+
+// LogValue implements the slog.LogValuer interface. The byte is logged as a string.
+func (n Byte) LogValue() slog.Value {
+	if !n.IsValid() {
+		return slog.AnyValue(nil)
+	}
+
+	return slog.StringValue(string(n.value))
+}
+
+// Format implements the fmt.Formatter interface. %v and %s print the byte as a character,
+// while the other verbs, such as %d, %x and %q, are passed through to the byte.
+func (n Byte) Format(f fmt.State, verb rune) {
+	if n.IsValid() && (verb == 's' || (verb == 'v' && !f.Flag('#') && !f.Flag('+'))) {
+		fmt.Fprintf(f, fmt.FormatString(f, 's'), string(n.value))
+
+		return
+	}
+
+	format(f, verb, n, n.value, n.IsValid(), n.currentConfig().NullText)
+}
@@ -286,16 +286,24 @@ func TestByteIsZero(t *testing.T) {
 	)
 
 	zero := NewByte(0, true)
-	assert.True(
+	assert.False(
 		t,
 		zero.IsZero(),
 	)
+	assert.True(
+		t,
+		zero.IsZeroValue(),
+	)
 
 	null := NewByte(0, false)
 	assert.True(
 		t,
 		null.IsZero(),
 	)
+	assert.True(
+		t,
+		null.IsNull(),
+	)
 }
 
 func TestByteSetValue(t *testing.T) {
@@ -371,3 +379,23 @@ func TestByteScan(t *testing.T) {
 		invalid,
 	)
 }
+
+func TestByteMarshalXML(t *testing.T) {
+	testData := newByteData()
+	assertXMLRoundTrip(t, ByteFrom(testData.Value), testData.String, Byte{})
+	assertXMLNull(t, Byte{}, Byte{})
+}
+
+func TestByteMarshalYAML(t *testing.T) {
+	testData := newByteData()
+	// A fixed letter is used, since YAML quotes letters such as y and n that read as booleans.
+	assertYAMLRoundTrip(t, ByteFrom('a'), "a", Byte{})
+	assertYAMLRoundTrip(t, ByteFrom('y'), `"y"`, Byte{})
+	assertYAMLNull(t, Byte{}, ByteFrom(testData.Value))
+}
+
+func TestByteMarshalBinary(t *testing.T) {
+	testData := newByteData()
+	assertBinaryRoundTrip(t, ByteFrom(testData.Value))
+	assertBinaryRoundTrip(t, Byte{})
+}
@@ -2,42 +2,90 @@ package null // import "github.com/Patrick-Batenburg/nullify/null"
 
 import (
 	"bytes"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"encoding/xml"
+	"math"
+	"strconv"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
 )
 
 // Bytes is a NullableImpl []byte.
+// It is encoded as standard base64 by json.Marshaler and encoding.TextMarshaler,
+// unless another encoding is selected with a BytesOptionFn.
 type Bytes struct {
 	NullableImpl[[]byte]
+
+	// encoding determines how the Bytes are encoded when json.Marshaler, json.Unmarshaler,
+	// encoding.TextMarshaler, encoding.TextUnmarshaler and the XML interfaces are called.
+	encoding bytesEncoding
 }
 
 // NewBytes creates a new Bytes
-func NewBytes(value []byte, valid bool) Bytes {
-	return Bytes{
+func NewBytes(value []byte, valid bool, options ...BytesOptionFn) Bytes {
+	n := &Bytes{
 		NullableImpl: New(value, valid),
 	}
+
+	for _, option := range options {
+		option(n)
+	}
+
+	return *n
 }
 
 // BytesFrom creates a new Bytes that will always be valid.
-func BytesFrom(value []byte) Bytes {
-	return Bytes{
+func BytesFrom(value []byte, options ...BytesOptionFn) Bytes {
+	n := &Bytes{
 		NullableImpl: From(value),
 	}
+
+	for _, option := range options {
+		option(n)
+	}
+
+	return *n
 }
 
 // BytesFromPtr creates a new Bytes that will be null if the value is nil.
-func BytesFromPtr(value *[]byte) Bytes {
-	return Bytes{
+func BytesFromPtr(value *[]byte, options ...BytesOptionFn) Bytes {
+	n := &Bytes{
 		NullableImpl: FromPtr(value),
 	}
+
+	for _, option := range options {
+		option(n)
+	}
+
+	return *n
 }
 
 // MarshalJSON implements json.Marshaler.
 func (n Bytes) MarshalJSON() ([]byte, error) {
-	if len(n.value) == 0 || n.value == nil {
+	if !n.IsValid() {
 		return NullStringBytes, nil
 	}
 
-	return n.value, nil
+	if n.encoding == bytesEncodingArray {
+		return n.appendArray(nil), nil
+	}
+
+	text, err := n.MarshalText()
+
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(string(text))
+
+	if err != nil {
+		return nil, NewMarshalError(n, err)
+	}
+
+	return data, nil
 }
 
 // MarshalText implements encoding.TextMarshaler.
@@ -46,7 +94,22 @@ func (n Bytes) MarshalText() ([]byte, error) {
 		return nil, nil
 	}
 
-	return n.value, nil
+	switch n.encoding {
+	case bytesEncodingBase64URL:
+		return base64.URLEncoding.AppendEncode(nil, n.value), nil
+	case bytesEncodingHex:
+		return hex.AppendEncode(nil, n.value), nil
+	case bytesEncodingRaw:
+		if !utf8.Valid(n.value) {
+			return nil, NewMarshalError(n, ErrInvalidUTF8)
+		}
+
+		return n.value, nil
+	case bytesEncodingArray:
+		return n.appendArray(nil), nil
+	default:
+		return base64.StdEncoding.AppendEncode(nil, n.value), nil
+	}
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
@@ -58,13 +121,23 @@ func (n *Bytes) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
-	var value string
+	if n.encoding == bytesEncodingArray {
+		return n.unmarshalArray(data)
+	}
+
+	var text string
 
-	if err := json.Unmarshal(data, &value); err != nil {
+	if err := json.Unmarshal(data, &text); err != nil {
 		return NewUnmarshalError(data, n, err)
 	}
 
-	n.value = []byte(value)
+	value, err := n.decode([]byte(text))
+
+	if err != nil {
+		return NewUnmarshalError(data, n, err)
+	}
+
+	n.value = value
 	n.valid = true
 
 	return nil
@@ -75,10 +148,188 @@ func (n *Bytes) UnmarshalText(text []byte) error {
 	if len(text) == 0 {
 		n.value = ZeroBytes
 		n.valid = false
-	} else {
-		n.value = append(n.value[0:0], text...)
-		n.valid = true
+
+		return nil
+	}
+
+	if n.encoding == bytesEncodingArray {
+		return n.unmarshalArray(text)
+	}
+
+	value, err := n.decode(text)
+
+	if err != nil {
+		return NewUnmarshalError(text, n, err)
+	}
+
+	n.value = value
+	n.valid = true
+
+	return nil
+}
+
+// decode decodes text according to the string encoding of the Bytes.
+func (n Bytes) decode(text []byte) ([]byte, error) {
+	switch n.encoding {
+	case bytesEncodingBase64URL:
+		return base64.URLEncoding.AppendDecode([]byte{}, text)
+	case bytesEncodingHex:
+		return hex.AppendDecode([]byte{}, text)
+	case bytesEncodingRaw:
+		if !utf8.Valid(text) {
+			return nil, ErrInvalidUTF8
+		}
+
+		return append([]byte{}, text...), nil
+	default:
+		return base64.StdEncoding.AppendDecode([]byte{}, text)
+	}
+}
+
+// appendArray appends the value encoded as a JSON array of numbers to b.
+func (n Bytes) appendArray(b []byte) []byte {
+	b = append(b, '[')
+
+	for i, item := range n.value {
+		if i > 0 {
+			b = append(b, ',')
+		}
+
+		b = strconv.AppendUint(b, uint64(item), 10)
+	}
+
+	return append(b, ']')
+}
+
+// unmarshalArray decodes a JSON array of numbers into the value.
+func (n *Bytes) unmarshalArray(data []byte) error {
+	var items []int
+
+	if err := json.Unmarshal(data, &items); err != nil {
+		return NewUnmarshalError(data, n, err)
+	}
+
+	value := make([]byte, len(items))
+
+	for i, item := range items {
+		if item < 0 || item > math.MaxUint8 {
+			return NewUnmarshalError(data, n, ErrByteOutOfRange)
+		}
+
+		value[i] = byte(item)
+	}
+
+	n.value = value
+	n.valid = true
+
+	return nil
+}
+
+// MarshalXML implements xml.Marshaler. The value is encoded like MarshalText.
+func (n Bytes) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, n.IsValid(), n.currentConfig().XMLNull, n.MarshalText)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr. The value is encoded like MarshalText.
+func (n Bytes) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, n.IsValid(), n.currentConfig().XMLNull, n.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler. The value is decoded like UnmarshalText.
+func (n *Bytes) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, n.UnmarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr. The value is decoded like UnmarshalText.
+func (n *Bytes) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, n.UnmarshalText)
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// The encoding is encoded along with the value.
+func (n Bytes) AppendBinary(b []byte) ([]byte, error) {
+	b = appendBinaryFlags(b, n.IsValid())
+	b = append(b, byte(n.encoding))
+
+	if !n.IsValid() {
+		return b, nil
+	}
+
+	return append(b, n.value...), nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (n Bytes) GobEncode() ([]byte, error) {
+	return n.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (n *Bytes) GobDecode(data []byte) error {
+	return n.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (n Bytes) MarshalBinary() ([]byte, error) {
+	return n.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (n *Bytes) UnmarshalBinary(data []byte) error {
+	valid, data, err := readBinaryFlags(data)
+
+	if err != nil {
+		return NewUnmarshalError(data, n, err)
+	}
+
+	if len(data) == 0 || data[0] > byte(bytesEncodingArray) {
+		return NewUnmarshalError(data, n, ErrInvalidBinaryData)
+	}
+
+	n.encoding = bytesEncoding(data[0])
+
+	return n.unmarshalBinaryValue(valid, data[1:])
+}
+
+// MarshalYAML implements yaml.Marshaler. Binary data that is not valid UTF-8 is encoded as !!binary.
+func (n Bytes) MarshalYAML() (any, error) {
+	if !n.IsValid() {
+		return nil, nil
+	}
+
+	return string(n.value), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (n *Bytes) UnmarshalYAML(node *yaml.Node) error {
+	if isYAMLNull(node) {
+		n.value = ZeroBytes
+		n.valid = false
+
+		return nil
+	}
+
+	var value string
+
+	if err := node.Decode(&value); err != nil {
+		return NewUnmarshalError(node.Value, n, err)
 	}
 
+	n.value = []byte(value)
+	n.valid = true
+
 	return nil
 }
+
+// Set implements the flag.Value interface.
+func (n *Bytes) Set(value string) error {
+	return setFlag(n, value)
+}
+
+// String implements the fmt.Stringer and flag.Value interfaces.
+func (n Bytes) String() string {
+	if !n.IsValid() {
+		return n.currentConfig().NullText
+	}
+
+	return textString(n.MarshalText)
+}
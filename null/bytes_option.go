@@ -0,0 +1,59 @@
+package null // import "github.com/Patrick-Batenburg/nullify/null"
+
+// BytesOptionFn is a type alias for a function that modifies a Bytes option.
+type BytesOptionFn = func(*Bytes)
+
+// bytesEncoding determines how Bytes are encoded as JSON and text.
+type bytesEncoding int
+
+const (
+	// bytesEncodingBase64 encodes Bytes as standard base64, like encoding/json does for []byte.
+	bytesEncodingBase64 bytesEncoding = iota
+
+	// bytesEncodingBase64URL encodes Bytes as URL-safe base64.
+	bytesEncodingBase64URL
+
+	// bytesEncodingHex encodes Bytes as hexadecimal.
+	bytesEncodingHex
+
+	// bytesEncodingRaw encodes Bytes as a raw UTF-8 string.
+	bytesEncodingRaw
+
+	// bytesEncodingArray encodes Bytes as a JSON array of numbers.
+	bytesEncodingArray
+)
+
+// WithBytesBase64Encoding encodes the Bytes as standard base64. This is the default encoding.
+func WithBytesBase64Encoding() BytesOptionFn {
+	return func(option *Bytes) {
+		option.encoding = bytesEncodingBase64
+	}
+}
+
+// WithBytesBase64URLEncoding encodes the Bytes as URL-safe base64.
+func WithBytesBase64URLEncoding() BytesOptionFn {
+	return func(option *Bytes) {
+		option.encoding = bytesEncodingBase64URL
+	}
+}
+
+// WithBytesHexEncoding encodes the Bytes as hexadecimal.
+func WithBytesHexEncoding() BytesOptionFn {
+	return func(option *Bytes) {
+		option.encoding = bytesEncodingHex
+	}
+}
+
+// WithBytesRawEncoding encodes the Bytes as a raw string, which must be valid UTF-8.
+func WithBytesRawEncoding() BytesOptionFn {
+	return func(option *Bytes) {
+		option.encoding = bytesEncodingRaw
+	}
+}
+
+// WithBytesArrayEncoding encodes the Bytes as a JSON array of numbers, such as [1,2,3].
+func WithBytesArrayEncoding() BytesOptionFn {
+	return func(option *Bytes) {
+		option.encoding = bytesEncodingArray
+	}
+}
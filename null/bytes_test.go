@@ -1,7 +1,10 @@
 package null
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"encoding/xml"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -137,7 +140,7 @@ func TestBytesUnmarshalJSON(t *testing.T) {
 func TestBytesUnmarshalText(t *testing.T) {
 	testData := newBytesData()
 	var nonzero Bytes
-	err := nonzero.UnmarshalText(testData.Bytes)
+	err := nonzero.UnmarshalText([]byte(testData.Base64))
 	require.NoError(t, err)
 	assert.Equal(
 		t,
@@ -162,7 +165,7 @@ func TestBytesUnmarshalText(t *testing.T) {
 
 func TestBytesMarshalJSON(t *testing.T) {
 	testData := newBytesData()
-	nonzero := BytesFrom(testData.JSONBytes)
+	nonzero := BytesFrom(testData.Value)
 	data, err := json.Marshal(nonzero)
 	require.NoError(t, err)
 	assert.Equal(
@@ -176,7 +179,7 @@ func TestBytesMarshalJSON(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(
 		t,
-		NullString,
+		`""`,
 		string(data),
 	)
 
@@ -197,7 +200,7 @@ func TestBytesMarshalText(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(
 		t,
-		testData.String,
+		testData.Base64,
 		string(data),
 		nonzero,
 	)
@@ -249,16 +252,24 @@ func TestBytesIsZero(t *testing.T) {
 	)
 
 	zero := NewBytes(ZeroBytes, true)
-	assert.True(
+	assert.False(
 		t,
 		zero.IsZero(),
 	)
+	assert.True(
+		t,
+		zero.IsZeroValue(),
+	)
 
 	null := NewBytes(ZeroBytes, false)
 	assert.True(
 		t,
 		null.IsZero(),
 	)
+	assert.True(
+		t,
+		null.IsNull(),
+	)
 }
 
 func TestBytesSetValue(t *testing.T) {
@@ -322,3 +333,203 @@ func TestBytesScan(t *testing.T) {
 		null,
 	)
 }
+
+func TestBytesMarshalXML(t *testing.T) {
+	testData := newBytesData()
+	encoded := base64.StdEncoding.EncodeToString(testData.Value)
+	assertXMLRoundTrip(t, BytesFrom(testData.Value), encoded, Bytes{})
+	assertXMLRoundTrip(t, BytesFrom([]byte{0, 1, 254, 255}), "AAH+/w==", Bytes{})
+	assertXMLNull(t, Bytes{}, Bytes{})
+
+	var badType Bytes
+	err := xml.Unmarshal([]byte(`<bytes>:)</bytes>`), &badType)
+	require.ErrorIs(t, err, ErrCannotUnmarshal)
+}
+
+func TestBytesMarshalYAML(t *testing.T) {
+	testData := newBytesData()
+	assertYAMLRoundTrip(t, BytesFrom(testData.Value), testData.String, Bytes{})
+	assertYAMLRoundTrip(t, BytesFrom([]byte{0, 1, 254, 255}), "!!binary AAH+/w==", Bytes{})
+	assertYAMLNull(t, Bytes{}, BytesFrom(testData.Value))
+}
+
+func TestBytesMarshalBinary(t *testing.T) {
+	testData := newBytesData()
+	assertBinaryRoundTrip(t, BytesFrom(testData.Value))
+	assertBinaryRoundTrip(t, BytesFrom([]byte{0, 1, 254, 255}))
+	assertBinaryRoundTrip(t, Bytes{})
+}
+
+func TestBytesEncodings(t *testing.T) {
+	value := []byte{0x00, 0xfb, 0xff, 'a'}
+
+	testCases := []struct {
+		name    string
+		options []BytesOptionFn
+		value   []byte
+		json    string
+		text    string
+	}{
+		{
+			name:  "default",
+			value: value,
+			json:  `"APv/YQ=="`,
+			text:  "APv/YQ==",
+		},
+		{
+			name:    "base64",
+			options: []BytesOptionFn{WithBytesBase64Encoding()},
+			value:   value,
+			json:    `"APv/YQ=="`,
+			text:    "APv/YQ==",
+		},
+		{
+			name:    "base64 url",
+			options: []BytesOptionFn{WithBytesBase64URLEncoding()},
+			value:   value,
+			json:    `"APv_YQ=="`,
+			text:    "APv_YQ==",
+		},
+		{
+			name:    "hex",
+			options: []BytesOptionFn{WithBytesHexEncoding()},
+			value:   value,
+			json:    `"00fbff61"`,
+			text:    "00fbff61",
+		},
+		{
+			name:    "raw",
+			options: []BytesOptionFn{WithBytesRawEncoding()},
+			value:   []byte(`héllo "world"`),
+			json:    `"héllo \"world\""`,
+			text:    `héllo "world"`,
+		},
+		{
+			name:    "array",
+			options: []BytesOptionFn{WithBytesArrayEncoding()},
+			value:   value,
+			json:    `[0,251,255,97]`,
+			text:    `[0,251,255,97]`,
+		},
+		{
+			name:    "empty array",
+			options: []BytesOptionFn{WithBytesArrayEncoding()},
+			value:   EmptyBytes,
+			json:    `[]`,
+			text:    `[]`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			n := BytesFrom(testCase.value, testCase.options...)
+
+			data, err := n.MarshalJSON()
+			require.NoError(t, err)
+			assert.Equal(t, testCase.json, string(data))
+
+			text, err := n.MarshalText()
+			require.NoError(t, err)
+			assert.Equal(t, testCase.text, string(text))
+
+			unmarshaledJSON := NewBytes(ZeroBytes, false, testCase.options...)
+			require.NoError(t, unmarshaledJSON.UnmarshalJSON(data))
+			assert.Equal(t, n, unmarshaledJSON)
+
+			unmarshaledText := NewBytes(ZeroBytes, false, testCase.options...)
+			require.NoError(t, unmarshaledText.UnmarshalText(text))
+			assert.Equal(t, n, unmarshaledText)
+
+			assertXMLRoundTrip(t, n, testCase.text, NewBytes(ZeroBytes, false, testCase.options...))
+			assertBinaryRoundTrip(t, n)
+			assertGobKeepsJSON(t, n)
+		})
+	}
+}
+
+func TestBytesDecodedValuesDoNotAlias(t *testing.T) {
+	for _, option := range []BytesOptionFn{
+		WithBytesBase64Encoding(),
+		WithBytesBase64URLEncoding(),
+		WithBytesHexEncoding(),
+		WithBytesRawEncoding(),
+	} {
+		first := NewBytes(ZeroBytes, false, option)
+		second := NewBytes(ZeroBytes, false, option)
+		text, err := BytesFrom([]byte("ab"), option).MarshalText()
+		require.NoError(t, err)
+		require.NoError(t, first.UnmarshalText(text))
+		require.NoError(t, second.UnmarshalText(text))
+
+		first.ValueOrZero()[0] = 'x'
+		assert.Equal(t, []byte("ab"), second.ValueOrZero())
+		assert.Equal(t, []byte{}, EmptyBytes)
+	}
+}
+
+func TestBytesEncodingErrors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		options []BytesOptionFn
+		data    string
+		err     error
+	}{
+		{
+			name: "base64",
+			data: `"not base64!"`,
+			err:  base64.CorruptInputError(3),
+		},
+		{
+			name:    "base64 url",
+			options: []BytesOptionFn{WithBytesBase64URLEncoding()},
+			data:    `"APv/YQ=="`,
+			err:     base64.CorruptInputError(3),
+		},
+		{
+			name:    "hex",
+			options: []BytesOptionFn{WithBytesHexEncoding()},
+			data:    `"0g"`,
+			err:     hex.InvalidByteError('g'),
+		},
+		{
+			name:    "array out of range",
+			options: []BytesOptionFn{WithBytesArrayEncoding()},
+			data:    `[1,256]`,
+			err:     ErrByteOutOfRange,
+		},
+		{
+			name:    "array negative",
+			options: []BytesOptionFn{WithBytesArrayEncoding()},
+			data:    `[-1]`,
+			err:     ErrByteOutOfRange,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			n := NewBytes(ZeroBytes, false, testCase.options...)
+			err := n.UnmarshalJSON([]byte(testCase.data))
+			require.ErrorIs(t, err, ErrCannotUnmarshal)
+			require.ErrorIs(t, err, testCase.err)
+			assert.False(t, n.IsValid())
+		})
+	}
+
+	_, err := BytesFrom([]byte{0xff}, WithBytesRawEncoding()).MarshalJSON()
+	require.ErrorIs(t, err, ErrCannotMarshal)
+	require.ErrorIs(t, err, ErrInvalidUTF8)
+
+	raw := NewBytes(ZeroBytes, false, WithBytesRawEncoding())
+	err = raw.UnmarshalText([]byte{0xff})
+	require.ErrorIs(t, err, ErrCannotUnmarshal)
+	require.ErrorIs(t, err, ErrInvalidUTF8)
+	assert.False(t, raw.IsValid())
+
+	err = raw.UnmarshalBinary([]byte{binaryValidFlag, byte(bytesEncodingArray) + 1})
+	require.ErrorIs(t, err, ErrInvalidBinaryData)
+
+	var array Bytes
+	err = array.UnmarshalJSON([]byte(`[1,2]`))
+	var typeErr *json.UnmarshalTypeError
+	require.ErrorAs(t, err, &typeErr)
+}
@@ -0,0 +1,106 @@
+package null // import "github.com/Patrick-Batenburg/nullify/null"
+
+// Getter is the read-only subset of GenericNullable that is shared by
+// NullableImpl[T] and every concrete type embedding it, such as Int or Time.
+// It is used as a constraint so package-level helpers accept both by value.
+type Getter[T any] interface {
+	// IsValid returns true if the value is valid.
+	IsValid() bool
+
+	// ValueOrZero returns the inner value if valid, otherwise the zero value of T.
+	ValueOrZero() T
+}
+
+// Map applies fn to the inner value of n and returns the result as a valid NullableImpl.
+// If n is invalid then fn is not called and an invalid NullableImpl is returned.
+func Map[N Getter[T], T, U any](n N, fn func(T) U) NullableImpl[U] {
+	if !n.IsValid() {
+		return NullableImpl[U]{}
+	}
+
+	return From(fn(n.ValueOrZero()))
+}
+
+// FlatMap applies fn to the inner value of n and returns the nullable it produces.
+// If n is invalid then fn is not called and an invalid NullableImpl is returned.
+func FlatMap[N Getter[T], M Getter[U], T, U any](n N, fn func(T) M) NullableImpl[U] {
+	if !n.IsValid() {
+		return NullableImpl[U]{}
+	}
+
+	result := fn(n.ValueOrZero())
+
+	return New(result.ValueOrZero(), result.IsValid())
+}
+
+// Filter returns n as a NullableImpl if it is valid and its inner value satisfies predicate.
+// Otherwise an invalid NullableImpl is returned.
+func Filter[N Getter[T], T any](n N, predicate func(T) bool) NullableImpl[T] {
+	if !n.IsValid() || !predicate(n.ValueOrZero()) {
+		return NullableImpl[T]{}
+	}
+
+	return From(n.ValueOrZero())
+}
+
+// OrElse returns the inner value of n if valid, otherwise fallback.
+func OrElse[N Getter[T], T any](n N, fallback T) T {
+	if !n.IsValid() {
+		return fallback
+	}
+
+	return n.ValueOrZero()
+}
+
+// OrElseGet returns the inner value of n if valid, otherwise the result of calling fn.
+// fn is only called when n is invalid.
+func OrElseGet[N Getter[T], T any](n N, fn func() T) T {
+	if !n.IsValid() {
+		return fn()
+	}
+
+	return n.ValueOrZero()
+}
+
+// Zip2 combines the inner values of a and b with fn.
+// If any of the values is invalid then fn is not called and an invalid NullableImpl is returned.
+func Zip2[A Getter[T1], B Getter[T2], T1, T2, R any](a A, b B, fn func(T1, T2) R) NullableImpl[R] {
+	if !a.IsValid() || !b.IsValid() {
+		return NullableImpl[R]{}
+	}
+
+	return From(fn(a.ValueOrZero(), b.ValueOrZero()))
+}
+
+// Zip3 combines the inner values of a, b and c with fn.
+// If any of the values is invalid then fn is not called and an invalid NullableImpl is returned.
+func Zip3[A Getter[T1], B Getter[T2], C Getter[T3], T1, T2, T3, R any](
+	a A,
+	b B,
+	c C,
+	fn func(T1, T2, T3) R,
+) NullableImpl[R] {
+	if !a.IsValid() || !b.IsValid() || !c.IsValid() {
+		return NullableImpl[R]{}
+	}
+
+	return From(fn(a.ValueOrZero(), b.ValueOrZero(), c.ValueOrZero()))
+}
+
+// Coalesce returns the first valid value, similar to SQL's COALESCE.
+// If none of the values are valid then an invalid NullableImpl is returned.
+func Coalesce[N Getter[T], T any](values ...N) NullableImpl[T] {
+	for _, value := range values {
+		if value.IsValid() {
+			return From(value.ValueOrZero())
+		}
+	}
+
+	return NullableImpl[T]{}
+}
+
+// NullIf returns an invalid NullableImpl if value equals sentinel, similar to SQL's NULLIF.
+// Otherwise a valid NullableImpl holding value is returned.
+func NullIf[T comparable](value T, sentinel T) NullableImpl[T] {
+	return New(value, value != sentinel)
+}
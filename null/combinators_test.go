@@ -0,0 +1,117 @@
+package null
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMap(t *testing.T) {
+	testData := newInt64Data()
+	nonzero := Map(Int64From(testData.Value), func(value int64) string {
+		return strconv.FormatInt(value, 10)
+	})
+	assert.Equal(t, From(testData.String), nonzero)
+
+	called := false
+	null := Map(NewInt64(testData.Value, false), func(value int64) string {
+		called = true
+
+		return strconv.FormatInt(value, 10)
+	})
+	assert.False(t, called)
+	assert.Equal(t, NullableImpl[string]{}, null)
+
+	generic := Map(From(testData.Value), func(value int64) int64 {
+		return value / 2
+	})
+	assert.Equal(t, From(testData.Value/2), generic)
+}
+
+func TestFlatMap(t *testing.T) {
+	testData := newStringData()
+	parse := func(value string) Int64 {
+		number, err := strconv.ParseInt(value, 10, 64)
+
+		return NewInt64(number, err == nil)
+	}
+
+	valid := FlatMap(StringFrom("42"), parse)
+	assert.Equal(t, From(int64(42)), valid)
+
+	invalid := FlatMap(StringFrom(testData.Value), parse)
+	assert.False(t, invalid.IsValid())
+
+	null := FlatMap(NewString(testData.Value, false), parse)
+	assert.False(t, null.IsValid())
+}
+
+func TestFilter(t *testing.T) {
+	isPositive := func(value int) bool {
+		return value > 0
+	}
+
+	assert.Equal(t, From(1), Filter(IntFrom(1), isPositive))
+	assert.False(t, Filter(IntFrom(-1), isPositive).IsValid())
+	assert.False(t, Filter(NewInt(1, false), isPositive).IsValid())
+}
+
+func TestOrElse(t *testing.T) {
+	testData := newStringData()
+	fallback := gofakeit.LetterN(8)
+
+	assert.Equal(t, testData.Value, OrElse(StringFrom(testData.Value), fallback))
+	assert.Equal(t, fallback, OrElse(NewString(testData.Value, false), fallback))
+	assert.Equal(t, ZeroString, OrElse(StringFrom(ZeroString), fallback))
+}
+
+func TestOrElseGet(t *testing.T) {
+	value := time.Unix(gofakeit.Date().Unix(), 0)
+	fallback := time.Unix(gofakeit.Date().Unix(), 0)
+	called := false
+	fn := func() time.Time {
+		called = true
+
+		return fallback
+	}
+
+	assert.Equal(t, value, OrElseGet(TimeFrom(value), fn))
+	assert.False(t, called)
+
+	assert.Equal(t, fallback, OrElseGet(NewTime(value, false), fn))
+	assert.True(t, called)
+}
+
+func TestZip(t *testing.T) {
+	add := func(a int32, b int64) int64 {
+		return int64(a) + b
+	}
+
+	assert.Equal(t, From(int64(3)), Zip2(Int32From(1), Int64From(2), add))
+	assert.False(t, Zip2(NewInt32(1, false), Int64From(2), add).IsValid())
+	assert.False(t, Zip2(Int32From(1), NewInt64(2, false), add).IsValid())
+
+	join := func(a string, b int, c bool) string {
+		return a + strconv.Itoa(b) + strconv.FormatBool(c)
+	}
+
+	assert.Equal(t, From("a1true"), Zip3(StringFrom("a"), IntFrom(1), BoolFrom(true), join))
+	assert.False(t, Zip3(StringFrom("a"), IntFrom(1), NewBool(true, false), join).IsValid())
+}
+
+func TestCoalesce(t *testing.T) {
+	assert.Equal(t, From(2), Coalesce(NewInt(1, false), IntFrom(2), IntFrom(3)))
+	assert.Equal(t, From(ZeroInt), Coalesce(IntFrom(ZeroInt), IntFrom(3)))
+	assert.False(t, Coalesce(NewInt(1, false), NewInt(2, false)).IsValid())
+	assert.False(t, Coalesce[Int]().IsValid())
+	assert.Equal(t, From("a"), Coalesce(New("", false), From("a")))
+}
+
+func TestNullIf(t *testing.T) {
+	assert.Equal(t, From(1), NullIf(1, 0))
+	assert.False(t, NullIf(0, 0).IsValid())
+	assert.False(t, NullIf(ZeroString, ZeroString).IsValid())
+}
@@ -0,0 +1,130 @@
+package null // import "github.com/Patrick-Batenburg/nullify/null"
+
+import (
+	"bytes"
+	"cmp"
+	"iter"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NullsOrder determines where invalid values are placed when comparing nullables.
+// It mirrors SQL's NULLS FIRST and NULLS LAST ordering.
+type NullsOrder int
+
+const (
+	// NullsLast orders invalid values after valid values.
+	// This matches the default of an ascending ORDER BY in Postgres.
+	NullsLast NullsOrder = iota
+
+	// NullsFirst orders invalid values before valid values.
+	NullsFirst
+)
+
+// Compare returns -1, 0 or +1 depending on whether a is less than, equal to,
+// or greater than b. Invalid values are ordered according to order, and two
+// invalid values are considered equal. It can be used with slices.SortFunc.
+//
+// Negating the result reverses the order of valid values and invalid values alike,
+// so a descending sort with NullsLast places invalid values first, the same as
+// Postgres does for ORDER BY ... DESC.
+func Compare[N Getter[T], T cmp.Ordered](a N, b N, order NullsOrder) int {
+	return CompareFunc(a, b, order, cmp.Compare[T])
+}
+
+// CompareFunc is like Compare but uses a custom comparison function on the inner values.
+func CompareFunc[N Getter[T], T any](a N, b N, order NullsOrder, compare func(T, T) int) int {
+	switch {
+	case !a.IsValid() && !b.IsValid():
+		return 0
+	case !a.IsValid():
+		if order == NullsFirst {
+			return -1
+		}
+
+		return 1
+	case !b.IsValid():
+		if order == NullsFirst {
+			return 1
+		}
+
+		return -1
+	default:
+		return compare(a.ValueOrZero(), b.ValueOrZero())
+	}
+}
+
+// CompareTime compares two time values by instant using time.Time.Compare.
+func CompareTime[N Getter[time.Time]](a N, b N, order NullsOrder) int {
+	return CompareFunc(a, b, order, time.Time.Compare)
+}
+
+// CompareUUID compares two uuid values by their byte order,
+// which matches how Postgres orders the uuid type.
+func CompareUUID[N Getter[uuid.UUID]](a N, b N, order NullsOrder) int {
+	return CompareFunc(a, b, order, func(x uuid.UUID, y uuid.UUID) int {
+		return bytes.Compare(x[:], y[:])
+	})
+}
+
+// Compare compares n with other by instant. See CompareTime.
+func (n Time) Compare(other Time, order NullsOrder) int {
+	return CompareTime(n, other, order)
+}
+
+// Compare compares n with other by byte order. See CompareUUID.
+func (n UUID) Compare(other UUID, order NullsOrder) int {
+	return CompareUUID(n, other, order)
+}
+
+// Min returns the smallest valid value, skipping invalid values.
+// If none of the values are valid then an invalid NullableImpl is returned.
+func Min[N Getter[T], T cmp.Ordered](values ...N) NullableImpl[T] {
+	return MinSeq(slices.Values(values))
+}
+
+// Max returns the largest valid value, skipping invalid values.
+// If none of the values are valid then an invalid NullableImpl is returned.
+func Max[N Getter[T], T cmp.Ordered](values ...N) NullableImpl[T] {
+	return MaxSeq(slices.Values(values))
+}
+
+// MinFunc is like Min but uses a custom comparison function on the inner values.
+// If several values are minimal then the first one is returned.
+func MinFunc[N Getter[T], T any](compare func(T, T) int, values ...N) NullableImpl[T] {
+	return minFuncSeq(compare, slices.Values(values))
+}
+
+// MaxFunc is like Max but uses a custom comparison function on the inner values.
+// If several values are maximal then the first one is returned.
+func MaxFunc[N Getter[T], T any](compare func(T, T) int, values ...N) NullableImpl[T] {
+	return maxFuncSeq(compare, slices.Values(values))
+}
+
+// minFuncSeq returns the first minimal valid value yielded by seq in a single pass.
+func minFuncSeq[N Getter[T], T any](compare func(T, T) int, seq iter.Seq[N]) NullableImpl[T] {
+	var result NullableImpl[T]
+
+	for value := range Values(seq) {
+		if !result.IsValid() || compare(value, result.value) < 0 {
+			result = From(value)
+		}
+	}
+
+	return result
+}
+
+// maxFuncSeq returns the first maximal valid value yielded by seq in a single pass.
+func maxFuncSeq[N Getter[T], T any](compare func(T, T) int, seq iter.Seq[N]) NullableImpl[T] {
+	var result NullableImpl[T]
+
+	for value := range Values(seq) {
+		if !result.IsValid() || compare(value, result.value) > 0 {
+			result = From(value)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,109 @@
+package null
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+	assert.Equal(t, -1, Compare(Int64From(1), Int64From(2), NullsLast))
+	assert.Equal(t, 1, Compare(Int64From(2), Int64From(1), NullsLast))
+	assert.Equal(t, 0, Compare(Int64From(1), Int64From(1), NullsLast))
+	assert.Equal(t, 0, Compare(NewInt64(1, false), NewInt64(2, false), NullsLast))
+
+	assert.Equal(t, 1, Compare(NewInt64(1, false), Int64From(2), NullsLast))
+	assert.Equal(t, -1, Compare(Int64From(2), NewInt64(1, false), NullsLast))
+	assert.Equal(t, -1, Compare(NewInt64(1, false), Int64From(2), NullsFirst))
+	assert.Equal(t, 1, Compare(Int64From(2), NewInt64(1, false), NullsFirst))
+}
+
+func TestCompareSortFunc(t *testing.T) {
+	values := []Int64{Int64From(3), NewInt64(0, false), Int64From(1), Int64From(2)}
+
+	nullsLast := slices.Clone(values)
+	slices.SortFunc(nullsLast, func(a Int64, b Int64) int {
+		return Compare(a, b, NullsLast)
+	})
+	assert.Equal(
+		t,
+		[]Int64{Int64From(1), Int64From(2), Int64From(3), NewInt64(0, false)},
+		nullsLast,
+	)
+
+	nullsFirst := slices.Clone(values)
+	slices.SortFunc(nullsFirst, func(a Int64, b Int64) int {
+		return Compare(a, b, NullsFirst)
+	})
+	assert.Equal(
+		t,
+		[]Int64{NewInt64(0, false), Int64From(1), Int64From(2), Int64From(3)},
+		nullsFirst,
+	)
+
+	descending := slices.Clone(values)
+	slices.SortFunc(descending, func(a Int64, b Int64) int {
+		return -Compare(a, b, NullsLast)
+	})
+	assert.Equal(
+		t,
+		[]Int64{NewInt64(0, false), Int64From(3), Int64From(2), Int64From(1)},
+		descending,
+	)
+}
+
+func TestCompareTime(t *testing.T) {
+	now := time.Now()
+	utc := now.UTC()
+	later := now.Add(time.Second)
+
+	assert.Equal(t, 0, TimeFrom(now).Compare(TimeFrom(utc), NullsLast))
+	assert.Equal(t, -1, TimeFrom(now).Compare(TimeFrom(later), NullsLast))
+	assert.Equal(t, 1, TimeFrom(later).Compare(TimeFrom(now), NullsLast))
+	assert.Equal(t, 1, NewTime(now, false).Compare(TimeFrom(now), NullsLast))
+	assert.Equal(t, -1, CompareTime(NewTime(now, false), TimeFrom(now), NullsFirst))
+}
+
+func TestCompareUUID(t *testing.T) {
+	low := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	high := uuid.MustParse("ffffffff-0000-0000-0000-000000000000")
+
+	assert.Equal(t, -1, UUIDFrom(low).Compare(UUIDFrom(high), NullsLast))
+	assert.Equal(t, 1, UUIDFrom(high).Compare(UUIDFrom(low), NullsLast))
+	assert.Equal(t, 0, UUIDFrom(low).Compare(UUIDFrom(low), NullsLast))
+	assert.Equal(t, -1, CompareUUID(NewUUID(high, false), UUIDFrom(low), NullsFirst))
+}
+
+func TestMinMax(t *testing.T) {
+	values := []Int{NewInt(-10, false), IntFrom(3), IntFrom(1), NewInt(10, false), IntFrom(2)}
+
+	assert.Equal(t, From(1), Min(values...))
+	assert.Equal(t, From(3), Max(values...))
+	assert.False(t, Min(NewInt(1, false)).IsValid())
+	assert.False(t, Max[Int]().IsValid())
+
+	now := time.Now()
+	assert.Equal(
+		t,
+		From(now),
+		MinFunc(
+			time.Time.Compare,
+			NewTime(now.Add(-time.Hour), false),
+			TimeFrom(now),
+			TimeFrom(now.Add(time.Hour)),
+		),
+	)
+	assert.Equal(
+		t,
+		From(now.Add(time.Hour)),
+		MaxFunc(
+			time.Time.Compare,
+			TimeFrom(now),
+			TimeFrom(now.Add(time.Hour)),
+			NewTime(now.Add(2*time.Hour), false),
+		),
+	)
+}
@@ -0,0 +1,195 @@
+package null // import "github.com/Patrick-Batenburg/nullify/null"
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// Config holds the settings that are shared by all nullable values, such as the default time layout
+// and the text of invalid values. A Config is used as the process default, attached to a context,
+// or bound to the nullable fields of a struct by Apply and Decoder.WithConfig.
+// The zero Config is not useful, use DefaultConfig and change its fields instead.
+type Config struct {
+	// TimeLayout is the layout of a Time that has no layout of its own,
+	// such as a Time that was decoded into a zero struct. It defaults to RFC3339.
+	TimeLayout string
+
+	// PreferMonthFirst determines if an ambiguous date such as 02/03/2024 is parsed
+	// as February 3rd rather than March 2nd. It defaults to true.
+	PreferMonthFirst bool
+
+	// NullText is printed for invalid values by fmt.Stringer and fmt.Formatter. It defaults to "NULL".
+	NullText string
+
+	// TrueText and FalseText are the text representations of a Bool.
+	// They are accepted by encoding.TextUnmarshaler, along with the values accepted by strconv.ParseBool.
+	// They default to "true" and "false".
+	TrueText  string
+	FalseText string
+
+	// IntegerValuer sets the destination type of integers without a valuer type of their own
+	// when driver.Valuer is called, such as WithInt64Valuer. It defaults to nil, which keeps the type.
+	IntegerValuer IntegerOption
+
+	// EmptyAsNull determines if an empty String is considered null. It defaults to false.
+	EmptyAsNull bool
+
+	// XMLNull determines how invalid values are represented by xml.Marshaler and xml.MarshalerAttr.
+	// It defaults to XMLNullOmit.
+	XMLNull XMLNullMode
+
+	// RedactedText replaces the value of redacted attributes when logging. It defaults to "[REDACTED]".
+	RedactedText string
+}
+
+// contextKey is the key of the Config in a context.
+type contextKey struct{}
+
+// defaultConfig is the Config set by SetDefaultConfig.
+var defaultConfig atomic.Pointer[Config]
+
+// DefaultConfig returns the process default Config.
+// Until SetDefaultConfig is called, PreferMonthFirst, TrueText and FalseText are read
+// from the deprecated package-level variables without synchronization, so those variables
+// must only be changed before any value is used, such as at the start of main,
+// and never concurrently. Use SetDefaultConfig to change the default at any time.
+func DefaultConfig() Config {
+	if config := defaultConfig.Load(); config != nil {
+		return *config
+	}
+
+	return Config{
+		TimeLayout:       time.RFC3339,
+		PreferMonthFirst: DateParsePreferMonthFirst,
+		NullText:         "NULL",
+		TrueText:         TrueString,
+		FalseText:        FalseString,
+		XMLNull:          XMLNullOmit,
+		RedactedText:     "[REDACTED]",
+	}
+}
+
+// SetDefaultConfig sets the process default Config. It is safe for concurrent use,
+// and the package-level variables are no longer read once it has been called.
+func SetDefaultConfig(config Config) {
+	defaultConfig.Store(&config)
+}
+
+// ContextWithConfig returns a copy of ctx that carries config.
+func ContextWithConfig(ctx context.Context, config Config) context.Context {
+	return context.WithValue(ctx, contextKey{}, config)
+}
+
+// ConfigFromContext returns the Config carried by ctx, or DefaultConfig if there is none.
+func ConfigFromContext(ctx context.Context) Config {
+	if config, ok := ctx.Value(contextKey{}).(Config); ok {
+		return config
+	}
+
+	return DefaultConfig()
+}
+
+// Apply binds the Config to the nullable fields of the value pointed to by dest, including the fields
+// of nested structs and the elements of slices, arrays and pointers, and applies their TagName options.
+// Bound values use the Config instead of the process default, also when they are scanned or decoded later.
+//
+// The values share a copy of the Config that is made by Apply, so changing the Config afterwards
+// does not affect them and they are safe for concurrent use. Since the copy is bound by pointer,
+// values bound by different calls to Apply are not equal by == or as map keys, use Equal instead.
+func (c Config) Apply(dest any) error {
+	config := c
+
+	if err := walkNullables(dest, func(n configBinder, _ string, _ string) error {
+		n.bindConfig(&config)
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return ApplyTags(dest)
+}
+
+// UnmarshalContext parses the JSON-encoded data and stores the result in the value pointed to by dest,
+// like Unmarshal, with the Config carried by ctx bound to its nullable fields.
+func UnmarshalContext(ctx context.Context, data []byte, dest any) error {
+	config := ConfigFromContext(ctx)
+
+	if err := config.Apply(dest); err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(data, dest); err != nil {
+		return err
+	}
+
+	return config.Apply(dest)
+}
+
+// bindConfig binds config to the value.
+func (n *NullableImpl[T]) bindConfig(config *Config) {
+	n.config = config
+}
+
+// currentConfig returns the Config bound to the value, or DefaultConfig if there is none.
+func (n NullableImpl[T]) currentConfig() Config {
+	if n.config != nil {
+		return *n.config
+	}
+
+	return DefaultConfig()
+}
+
+// parseBool parses text as a bool, accepting the TrueText and FalseText of the Config
+// and the values accepted by strconv.ParseBool.
+func (c Config) parseBool(text string) (bool, bool) {
+	switch {
+	case c.TrueText != ZeroString && strings.EqualFold(text, c.TrueText):
+		return true, true
+	case c.FalseText != ZeroString && strings.EqualFold(text, c.FalseText):
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+// boolText returns the text representation of value.
+func (c Config) boolText(value bool) []byte {
+	switch {
+	case value && c.TrueText != ZeroString:
+		return []byte(c.TrueText)
+	case value:
+		return TrueStringBytes
+	case c.FalseText != ZeroString:
+		return []byte(c.FalseText)
+	default:
+		return FalseStringBytes
+	}
+}
+
+// timeLayout returns layout, or the TimeLayout of the Config if layout is empty.
+func (c Config) timeLayout(layout string) string {
+	switch {
+	case layout != ZeroString:
+		return layout
+	case c.TimeLayout != ZeroString:
+		return c.TimeLayout
+	default:
+		return time.RFC3339
+	}
+}
+
+// valuerType returns valuerType, or the type set by the IntegerValuer of the Config if it is nil.
+func (c Config) valuerType(valuerType any) any {
+	if valuerType != nil || c.IntegerValuer == nil {
+		return valuerType
+	}
+
+	option := new(integerOption)
+	c.IntegerValuer(option)
+
+	return option.valuerType
+}
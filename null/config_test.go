@@ -0,0 +1,179 @@
+package null
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type configDocument struct {
+	Active   Bool     `json:"active"`
+	Count    Int16    `json:"count"`
+	Explicit Int16    `json:"explicit" null:"valuer=int32"`
+	Name     String   `json:"name"`
+	Created  Time     `json:"created"`
+	Items    []String `json:"items"`
+	Nested   *configDocument
+}
+
+func newTestConfig() Config {
+	config := DefaultConfig()
+	config.TimeLayout = time.DateOnly
+	config.PreferMonthFirst = false
+	config.NullText = "<nil>"
+	config.TrueText = "yes"
+	config.FalseText = "no"
+	config.IntegerValuer = WithInt64Valuer()
+	config.EmptyAsNull = true
+	config.XMLNull = XMLNullEmpty
+
+	return config
+}
+
+func TestDefaultConfig(t *testing.T) {
+	config := DefaultConfig()
+	assert.Equal(t, time.RFC3339, config.TimeLayout)
+	assert.True(t, config.PreferMonthFirst)
+	assert.Equal(t, "NULL", config.NullText)
+	assert.Equal(t, TrueString, config.TrueText)
+	assert.Equal(t, FalseString, config.FalseText)
+	assert.Nil(t, config.IntegerValuer)
+	assert.False(t, config.EmptyAsNull)
+	assert.Equal(t, XMLNullOmit, config.XMLNull)
+	assert.Equal(t, "[REDACTED]", config.RedactedText)
+}
+
+func TestSetDefaultConfig(t *testing.T) {
+	t.Cleanup(func() {
+		defaultConfig.Store(nil)
+	})
+
+	SetDefaultConfig(newTestConfig())
+
+	assert.Equal(t, "<nil>", Int{}.String())
+	assert.Equal(t, "<nil>", fmt.Sprintf("%v", Time{}))
+	assert.Equal(t, "yes", BoolFrom(true).String())
+	assert.False(t, StringFrom(ZeroString, WithStringTrimSpace()).IsValid())
+
+	value, err := Int16From(1).Value()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), value)
+
+	var n Time
+	require.NoError(t, n.UnmarshalText([]byte("02/03/2024")))
+	assert.Equal(t, time.March, n.ValueOrZero().Month())
+	assert.Equal(t, "2024-03-02", n.FormatLayout(WithTimeLayoutFormat()))
+}
+
+func TestConfigFromContext(t *testing.T) {
+	assert.Equal(t, DefaultConfig().NullText, ConfigFromContext(context.Background()).NullText)
+
+	ctx := ContextWithConfig(context.Background(), newTestConfig())
+	assert.Equal(t, "<nil>", ConfigFromContext(ctx).NullText)
+}
+
+func TestConfigApply(t *testing.T) {
+	document := configDocument{
+		Name:   StringFrom(ZeroString),
+		Items:  []String{StringFrom(ZeroString)},
+		Nested: &configDocument{},
+	}
+	require.NoError(t, newTestConfig().Apply(&document))
+
+	assert.False(t, document.Name.IsValid())
+	assert.False(t, document.Items[0].IsValid())
+	assert.Equal(t, "<nil>", document.Nested.Count.String())
+	assert.Equal(t, "<nil>", fmt.Sprintf("%5v", document.Active))
+	assert.Equal(t, "NULL", Int16{}.String())
+
+	require.NoError(t, document.Active.UnmarshalText([]byte("YES")))
+	assert.True(t, document.Active.ValueOrZero())
+	require.NoError(t, document.Active.UnmarshalText([]byte("false")))
+	assert.Equal(t, "no", document.Active.String())
+
+	document.Count.SetValue(1)
+	value, err := document.Count.Value()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), value)
+
+	document.Explicit.SetValue(1)
+	value, err = document.Explicit.Value()
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), value)
+
+	data, err := xml.Marshal(struct {
+		XMLName xml.Name `xml:"document"`
+		Name    String   `xml:"name"`
+	}{Name: document.Name})
+	require.NoError(t, err)
+	assert.Equal(t, "<document><name></name></document>", string(data))
+
+	require.ErrorIs(t, newTestConfig().Apply(nil), ErrInvalidDestination)
+}
+
+func TestConfigApplyCopiesConfig(t *testing.T) {
+	config := newTestConfig()
+	document := configDocument{Name: StringFrom("a")}
+	require.NoError(t, config.Apply(&document))
+
+	config.NullText = "changed"
+	config.EmptyAsNull = false
+	assert.Equal(t, "<nil>", document.Count.String())
+
+	document.Name.SetValue(ZeroString)
+	assert.False(t, document.Name.IsValid())
+}
+
+func TestConfigApplyEquality(t *testing.T) {
+	var bound, other configDocument
+	require.NoError(t, newTestConfig().Apply(&bound))
+	require.NoError(t, newTestConfig().Apply(&other))
+
+	bound.Count.SetValue(1)
+	other.Count.SetValue(1)
+	unbound := Int16From(1)
+
+	// The bound configs are compared by pointer, so the values are not ==.
+	equalUnbound := bound.Count == unbound
+	equalOther := bound.Count == other.Count
+	assert.False(t, equalUnbound)
+	assert.False(t, equalOther)
+	assert.True(t, bound.Count.Equal(unbound.NullableImpl))
+	assert.True(t, bound.Count.Equal(other.Count.NullableImpl))
+}
+
+func TestUnmarshalContext(t *testing.T) {
+	ctx := ContextWithConfig(context.Background(), newTestConfig())
+	data := []byte(`{
+		"active": true,
+		"name": "",
+		"created": "2024-02-03T00:00:00Z",
+		"items": [""]
+	}`)
+
+	var document configDocument
+	require.NoError(t, UnmarshalContext(ctx, data, &document))
+
+	assert.Equal(t, "yes", document.Active.String())
+	assert.False(t, document.Name.IsValid())
+	assert.False(t, document.Items[0].IsValid())
+	assert.Equal(t, "2024-02-03", document.Created.String())
+}
+
+func TestDecoderWithConfig(t *testing.T) {
+	decoder := NewDecoder(strings.NewReader(`{"name": ""} {"name": ""}`))
+
+	var document configDocument
+	require.NoError(t, decoder.Decode(&document))
+	assert.True(t, document.Name.IsValid())
+
+	document = configDocument{}
+	require.NoError(t, decoder.WithConfig(newTestConfig()).Decode(&document))
+	assert.False(t, document.Name.IsValid())
+}
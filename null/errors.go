@@ -12,6 +12,12 @@ var (
 	ErrCannotUnmarshalByte = errors.New(
 		"null: cannot convert to byte, data length is greater than one",
 	)
+	ErrInvalidBinaryData = errors.New("null: invalid binary data")
+	ErrTimeEpochOverflow = errors.New("null: time overflows the epoch unit")
+	ErrTimeEpochFraction = errors.New("null: time has a fraction of the whole epoch unit")
+	ErrInvalidSQLiteTime = errors.New("null: invalid SQLite time value")
+	ErrInvalidUTF8       = errors.New("null: bytes are not valid UTF-8")
+	ErrByteOutOfRange    = errors.New("null: array element is out of byte range")
 
 	ErrCannotScan = errors.New("null: cannot scan type")
 
@@ -19,11 +25,19 @@ var (
 	ErrValuerCheckerAssertionFailed = errors.New("null: valuer checker assertion failed")
 	ErrValuerCheckerTypeUnsupported = errors.New("null: valuer checker type unsupported")
 	ErrValuerCheckerIntegerOverflow = errors.New("null: valuer checker integer overflow detected")
+	ErrValuerStringTooLong          = errors.New("null: string exceeds maximum length")
+
+	ErrCannotCalculate          = errors.New("null: cannot calculate type")
+	ErrArithmeticDivisionByZero = errors.New("null: division by zero")
 
 	ErrCannotMustValue = errors.New("null: cannot must value for type")
 	ErrDestinationNil  = errors.New("null: destination pointer is nil")
 
 	ErrCannotNewUUID = errors.New("null: uuid value must be a string or implement fmt.Stringer")
+
+	ErrInvalidTagOption     = errors.New("null: invalid tag option")
+	ErrTagOptionUnsupported = errors.New("null: tag option is not supported by the type")
+	ErrInvalidDestination   = errors.New("null: destination must be a non-nil pointer")
 )
 
 // MarshalError represents an error that occurs during marshaling.
@@ -161,3 +175,74 @@ func (e ValuerError) Error() string {
 func (e ValuerError) Unwrap() error {
 	return e.err
 }
+
+// ArithmeticError represents an error that occurs during an arithmetic operation.
+// It contains the original error and the source type.
+type ArithmeticError struct {
+	err        error
+	sourceType any
+}
+
+// NewArithmeticError creates a new ArithmeticError.
+// sourceType represents the type of the operand that caused the error.
+// It accepts multiple errors and wraps them together.
+func NewArithmeticError(sourceType any, errors ...error) error {
+	err := fmt.Errorf("%w %T", ErrCannotCalculate, sourceType)
+
+	for _, item := range errors {
+		err = fmt.Errorf("%w; %w", err, item)
+	}
+
+	return ArithmeticError{
+		err:        err,
+		sourceType: sourceType,
+	}
+}
+
+// Error returns the string representation of the ArithmeticError.
+func (e ArithmeticError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the underlying error for unwrapping.
+func (e ArithmeticError) Unwrap() error {
+	return e.err
+}
+
+// TagError represents an error that occurs when applying the null tag of a struct field.
+// It contains the original error and the name of the field.
+type TagError struct {
+	err   error
+	field string
+}
+
+// NewTagError creates a new TagError.
+// field is the name of the field and option is the tag option that caused the error.
+// It accepts multiple errors and wraps them together.
+func NewTagError(field string, option string, errors ...error) error {
+	err := fmt.Errorf("%w %q on field %s", ErrInvalidTagOption, option, field)
+
+	for _, item := range errors {
+		err = fmt.Errorf("%w; %w", err, item)
+	}
+
+	return TagError{
+		err:   err,
+		field: field,
+	}
+}
+
+// Field returns the name of the field whose tag caused the error.
+func (e TagError) Field() string {
+	return e.field
+}
+
+// Error returns the string representation of the TagError.
+func (e TagError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the underlying error for unwrapping.
+func (e TagError) Unwrap() error {
+	return e.err
+}
@@ -82,3 +82,18 @@ func TestValuerError(t *testing.T) {
 	require.ErrorAs(t, err, &unwrappedErr)
 	require.ErrorIs(t, unwrappedErr, ErrCannotValue)
 }
+
+func TestArithmeticError(t *testing.T) {
+	errMock := gofakeit.ErrorValidation()
+
+	sourceType := ZeroInt64
+	err := NewArithmeticError(sourceType, errMock)
+
+	expectedErrMsg := fmt.Sprintf("%v %T; %v", ErrCannotCalculate, sourceType, errMock)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), expectedErrMsg)
+
+	var unwrappedErr ArithmeticError
+	require.ErrorAs(t, err, &unwrappedErr)
+	require.ErrorIs(t, unwrappedErr, ErrCannotCalculate)
+}
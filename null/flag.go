@@ -0,0 +1,112 @@
+package null // import "github.com/Patrick-Batenburg/nullify/null"
+
+import (
+	"database/sql"
+	"encoding"
+	"flag"
+)
+
+var (
+	_ flag.Getter = (*NullableImpl[bool])(nil)
+	_ flag.Getter = (*Bool)(nil)
+	_ flag.Getter = (*Byte)(nil)
+	_ flag.Getter = (*Bytes)(nil)
+	_ flag.Getter = (*Float32)(nil)
+	_ flag.Getter = (*Float64)(nil)
+	_ flag.Getter = (*Int)(nil)
+	_ flag.Getter = (*Int8)(nil)
+	_ flag.Getter = (*Int16)(nil)
+	_ flag.Getter = (*Int32)(nil)
+	_ flag.Getter = (*Int64)(nil)
+	_ flag.Getter = (*JSON)(nil)
+	_ flag.Getter = (*String)(nil)
+	_ flag.Getter = (*Time)(nil)
+	_ flag.Getter = (*Uint)(nil)
+	_ flag.Getter = (*Uint8)(nil)
+	_ flag.Getter = (*Uint16)(nil)
+	_ flag.Getter = (*Uint32)(nil)
+	_ flag.Getter = (*Uint64)(nil)
+	_ flag.Getter = (*UUID)(nil)
+)
+
+// boolFlag is implemented by the flag.Value types that are set by a flag without a value, such as -v.
+type boolFlag interface {
+	IsBoolFlag() bool
+}
+
+var (
+	_ boolFlag = (*Bool)(nil)
+	_ boolFlag = (*OptionalBool)(nil)
+)
+
+// flagSetter is implemented by the nullable types that can be set from a flag.
+type flagSetter interface {
+	encoding.TextUnmarshaler
+	sql.Scanner
+	IsValid() bool
+}
+
+// Get implements the flag.Getter interface.
+// It returns the value, or nil if the value is invalid.
+func (n NullableImpl[T]) Get() any {
+	if !n.IsValid() {
+		return nil
+	}
+
+	return n.value
+}
+
+// Set implements the flag.Value interface. The value is parsed through UnmarshalText
+// and is always valid afterwards, so an empty string sets a valid empty value.
+// An unspecified flag keeps its value, which is invalid unless a default value was set.
+func (n *NullableImpl[T]) Set(value string) error {
+	return setFlag(n, value)
+}
+
+// IsBoolFlag reports that the flag can be set without a value, so -v sets it to true.
+func (n *Bool) IsBoolFlag() bool {
+	return true
+}
+
+// IsBoolFlag reports that the flag can be set without a value, so -v sets it to true.
+func (o *OptionalBool) IsBoolFlag() bool {
+	return true
+}
+
+// FlagsSet reports for every nullable flag defined in fs whether it was set on the command line.
+// If fs is nil, flag.CommandLine is used.
+func FlagsSet(fs *flag.FlagSet) map[string]bool {
+	if fs == nil {
+		fs = flag.CommandLine
+	}
+
+	result := make(map[string]bool)
+
+	fs.VisitAll(func(f *flag.Flag) {
+		if _, ok := f.Value.(interface{ IsValid() bool }); ok {
+			result[f.Name] = false
+		}
+	})
+
+	fs.Visit(func(f *flag.Flag) {
+		if _, ok := result[f.Name]; ok {
+			result[f.Name] = true
+		}
+	})
+
+	return result
+}
+
+// setFlag parses value through n.UnmarshalText. If that yields null, such as for an empty string
+// or "null", value is scanned instead, so that a flag that was passed is never null.
+func setFlag(n flagSetter, value string) error {
+	if err := n.UnmarshalText([]byte(value)); err != nil {
+		return err
+	}
+
+	if n.IsValid() {
+		return nil
+	}
+
+	return n.Scan(value)
+}
@@ -0,0 +1,215 @@
+package null
+
+import (
+	"bytes"
+	"flag"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestFlagSet() *flag.FlagSet {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+
+	return fs
+}
+
+func TestFlagValue(t *testing.T) {
+	id := uuid.New()
+
+	var (
+		boolValue    Bool
+		byteValue    Byte
+		bytesValue   Bytes
+		float32Value Float32
+		float64Value Float64
+		intValue     Int
+		int8Value    Int8
+		int16Value   Int16
+		int32Value   Int32
+		int64Value   Int64
+		jsonValue    JSON
+		stringValue  String
+		timeValue    = NewTime(ZeroTime, false, WithTimeLayout(time.DateOnly))
+		uintValue    Uint
+		uint8Value   Uint8
+		uint16Value  Uint16
+		uint32Value  Uint32
+		uint64Value  Uint64
+		uuidValue    UUID
+		genericValue NullableImpl[int]
+	)
+
+	values := map[string]flag.Getter{
+		"bool":    &boolValue,
+		"byte":    &byteValue,
+		"bytes":   &bytesValue,
+		"float32": &float32Value,
+		"float64": &float64Value,
+		"int":     &intValue,
+		"int8":    &int8Value,
+		"int16":   &int16Value,
+		"int32":   &int32Value,
+		"int64":   &int64Value,
+		"json":    &jsonValue,
+		"string":  &stringValue,
+		"time":    &timeValue,
+		"uint":    &uintValue,
+		"uint8":   &uint8Value,
+		"uint16":  &uint16Value,
+		"uint32":  &uint32Value,
+		"uint64":  &uint64Value,
+		"uuid":    &uuidValue,
+		"generic": &genericValue,
+	}
+
+	fs := newTestFlagSet()
+
+	for name, value := range values {
+		fs.Var(value, name, "usage")
+	}
+
+	err := fs.Parse([]string{
+		"-bool=true",
+		"-byte=b",
+		"-bytes=cmF3",
+		"-float32=1.5",
+		"-float64=2.5",
+		"-int=-1",
+		"-int8=-8",
+		"-int16=-16",
+		"-int32=-32",
+		"-json", `{"a":1}`,
+		"-string=",
+		"-time=2024-02-29",
+		"-uint=1",
+		"-uint8=8",
+		"-uint16=16",
+		"-uint32=32",
+		"-uint64=64",
+		"-uuid=" + id.String(),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, BoolFrom(true), boolValue)
+	assert.Equal(t, ByteFrom('b'), byteValue)
+	assert.Equal(t, BytesFrom([]byte("raw")), bytesValue)
+	assert.Equal(t, Float32From(1.5), float32Value)
+	assert.Equal(t, Float64From(2.5), float64Value)
+	assert.Equal(t, IntFrom(-1), intValue)
+	assert.Equal(t, Int8From(-8), int8Value)
+	assert.Equal(t, Int16From(-16), int16Value)
+	assert.Equal(t, Int32From(-32), int32Value)
+	assert.False(t, int64Value.IsValid())
+	assert.JSONEq(t, `{"a":1}`, string(jsonValue.ValueOrZero()))
+	assert.Equal(t, StringFrom(ZeroString), stringValue)
+	assert.Equal(t, "2024-02-29", timeValue.String())
+	assert.Equal(t, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), timeValue.ValueOrZero())
+	assert.Equal(t, UintFrom(1), uintValue)
+	assert.Equal(t, Uint8From(8), uint8Value)
+	assert.Equal(t, Uint16From(16), uint16Value)
+	assert.Equal(t, Uint32From(32), uint32Value)
+	assert.Equal(t, Uint64From(64), uint64Value)
+	assert.Equal(t, UUIDFrom(id), uuidValue)
+	assert.False(t, genericValue.IsValid())
+
+	assert.Equal(t, "-32", int32Value.String())
+	assert.Equal(t, id.String(), uuidValue.String())
+	assert.Equal(t, DefaultConfig().NullText, int64Value.String())
+	assert.Equal(t, int32(-32), int32Value.Get())
+	assert.Nil(t, int64Value.Get())
+
+	set := FlagsSet(fs)
+	assert.Len(t, set, len(values))
+	assert.True(t, set["string"])
+	assert.False(t, set["int64"])
+	assert.False(t, set["generic"])
+}
+
+func TestFlagValueErrors(t *testing.T) {
+	var (
+		intValue  Int
+		timeValue = NewTime(ZeroTime, false, WithTimeStrictParsing())
+		uuidValue UUID
+	)
+
+	require.ErrorIs(t, intValue.Set("x"), ErrCannotUnmarshal)
+	require.ErrorIs(t, intValue.Set(""), ErrCannotScan)
+	require.ErrorIs(t, timeValue.Set("02/03/2024"), ErrCannotUnmarshal)
+	require.ErrorIs(t, uuidValue.Set("x"), ErrCannotUnmarshal)
+	assert.False(t, uuidValue.IsValid())
+}
+
+func TestFlagsSet(t *testing.T) {
+	var (
+		name    String
+		retries = IntFrom(3)
+		plain   int
+	)
+
+	fs := newTestFlagSet()
+	fs.Var(&name, "name", "the name")
+	fs.Var(&retries, "retries", "the number of retries")
+	fs.IntVar(&plain, "plain", 0, "a plain flag")
+
+	err := fs.Parse([]string{"-name", "null", "-plain", "1"})
+	require.NoError(t, err)
+	assert.Equal(t, StringFrom(NullString), name)
+	assert.Equal(t, IntFrom(3), retries)
+	assert.Equal(t, map[string]bool{"name": true, "retries": false}, FlagsSet(fs))
+
+	var output bytes.Buffer
+	fs.SetOutput(&output)
+	fs.PrintDefaults()
+	assert.Contains(t, output.String(), "the number of retries (default 3)")
+	assert.NotContains(t, output.String(), "the name (default")
+}
+
+func TestOptionalSet(t *testing.T) {
+	var (
+		generic Optional[int]
+		typed   OptionalInt64
+		absent  OptionalBool
+	)
+
+	fs := newTestFlagSet()
+	fs.Var(&generic, "generic", "usage")
+	fs.Var(&typed, "typed", "usage")
+	fs.Var(&absent, "absent", "usage")
+
+	err := fs.Parse([]string{"-generic=1", "-typed=2"})
+	require.NoError(t, err)
+	assert.True(t, generic.IsValue())
+	assert.True(t, typed.IsValue())
+	assert.Equal(t, int64(2), typed.MustValue())
+	assert.False(t, absent.IsSet())
+}
+
+func TestBoolFlagWithoutValue(t *testing.T) {
+	var (
+		verbose  Bool
+		debug    OptionalBool
+		disabled Bool
+		absent   OptionalBool
+	)
+
+	fs := newTestFlagSet()
+	fs.Var(&verbose, "bool", "usage")
+	fs.Var(&debug, "optional", "usage")
+	fs.Var(&disabled, "disabled", "usage")
+	fs.Var(&absent, "absent", "usage")
+
+	err := fs.Parse([]string{"-bool", "-optional", "-disabled=false", "argument"})
+	require.NoError(t, err)
+	assert.Equal(t, BoolFrom(true), verbose)
+	assert.True(t, debug.IsValue())
+	assert.True(t, debug.MustValue())
+	assert.Equal(t, BoolFrom(false), disabled)
+	assert.False(t, absent.IsSet())
+	assert.Equal(t, []string{"argument"}, fs.Args())
+}
@@ -284,16 +284,24 @@ func TestFloat32IsZero(t *testing.T) {
 	)
 
 	zero := NewFloat32(ZeroFloat32, true)
-	assert.True(
+	assert.False(
 		t,
 		zero.IsZero(),
 	)
+	assert.True(
+		t,
+		zero.IsZeroValue(),
+	)
 
 	null := NewFloat32(ZeroFloat32, false)
 	assert.True(
 		t,
 		null.IsZero(),
 	)
+	assert.True(
+		t,
+		null.IsNull(),
+	)
 }
 
 func TestFloat32SetValue(t *testing.T) {
@@ -356,3 +364,21 @@ func TestFloat32Scan(t *testing.T) {
 		null,
 	)
 }
+
+func TestFloat32MarshalXML(t *testing.T) {
+	testData := newFloat32Data()
+	assertXMLRoundTrip(t, Float32From(testData.Value), testData.String, Float32{})
+	assertXMLNull(t, Float32{}, Float32{})
+}
+
+func TestFloat32MarshalYAML(t *testing.T) {
+	testData := newFloat32Data()
+	assertYAMLRoundTrip(t, Float32From(1.5), "1.5", Float32{})
+	assertYAMLNull(t, Float32{}, Float32From(testData.Value))
+}
+
+func TestFloat32MarshalBinary(t *testing.T) {
+	testData := newFloat32Data()
+	assertBinaryRoundTrip(t, Float32From(testData.Value))
+	assertBinaryRoundTrip(t, Float32{})
+}
@@ -284,16 +284,24 @@ func TestFloat64IsZero(t *testing.T) {
 	)
 
 	zero := NewFloat64(ZeroFloat64, true)
-	assert.True(
+	assert.False(
 		t,
 		zero.IsZero(),
 	)
+	assert.True(
+		t,
+		zero.IsZeroValue(),
+	)
 
 	null := NewFloat64(ZeroFloat64, false)
 	assert.True(
 		t,
 		null.IsZero(),
 	)
+	assert.True(
+		t,
+		null.IsNull(),
+	)
 }
 
 func TestFloat64SetValue(t *testing.T) {
@@ -356,3 +364,21 @@ func TestFloat64Scan(t *testing.T) {
 		null,
 	)
 }
+
+func TestFloat64MarshalXML(t *testing.T) {
+	testData := newFloat64Data()
+	assertXMLRoundTrip(t, Float64From(testData.Value), testData.String, Float64{})
+	assertXMLNull(t, Float64{}, Float64{})
+}
+
+func TestFloat64MarshalYAML(t *testing.T) {
+	testData := newFloat64Data()
+	assertYAMLRoundTrip(t, Float64From(1.5), "1.5", Float64{})
+	assertYAMLNull(t, Float64{}, Float64From(testData.Value))
+}
+
+func TestFloat64MarshalBinary(t *testing.T) {
+	testData := newFloat64Data()
+	assertBinaryRoundTrip(t, Float64From(testData.Value))
+	assertBinaryRoundTrip(t, Float64{})
+}
@@ -0,0 +1,90 @@
+package null // import "github.com/Patrick-Batenburg/nullify/null"
+
+import (
+	"fmt"
+	"strconv"
+)
+
+var (
+	_ fmt.Formatter = (*NullableImpl[bool])(nil)
+	_ fmt.Formatter = (*Bool)(nil)
+	_ fmt.Formatter = (*Byte)(nil)
+	_ fmt.Formatter = (*Bytes)(nil)
+	_ fmt.Formatter = (*Float32)(nil)
+	_ fmt.Formatter = (*Float64)(nil)
+	_ fmt.Formatter = (*Int)(nil)
+	_ fmt.Formatter = (*Int8)(nil)
+	_ fmt.Formatter = (*Int16)(nil)
+	_ fmt.Formatter = (*Int32)(nil)
+	_ fmt.Formatter = (*Int64)(nil)
+	_ fmt.Formatter = (*JSON)(nil)
+	_ fmt.Formatter = (*String)(nil)
+	_ fmt.Formatter = (*Time)(nil)
+	_ fmt.Formatter = (*Uint)(nil)
+	_ fmt.Formatter = (*Uint8)(nil)
+	_ fmt.Formatter = (*Uint16)(nil)
+	_ fmt.Formatter = (*Uint32)(nil)
+	_ fmt.Formatter = (*Uint64)(nil)
+	_ fmt.Formatter = (*UUID)(nil)
+)
+
+// String implements the fmt.Stringer and flag.Value interfaces.
+// It returns the text representation of the value, or the NullText of the Config if the value is invalid.
+func (n NullableImpl[T]) String() string {
+	if !n.IsValid() {
+		return n.currentConfig().NullText
+	}
+
+	return textString(n.MarshalText)
+}
+
+// Format implements the fmt.Formatter interface.
+//
+// The verbs and flags of valid values are passed through to the inner value, so %v prints the
+// value, %q quotes it and numeric verbs such as %d, %.2f and %x format numbers.
+// Invalid values print the NullText of the Config for every verb, honouring the width and the - flag.
+// %+v prints the value and its validity, and %#v prints a Go-syntax representation.
+func (n NullableImpl[T]) Format(f fmt.State, verb rune) {
+	format(f, verb, n, n.value, n.valid, n.currentConfig().NullText)
+}
+
+// format implements fmt.Formatter for the nullable source with the given value and validity.
+// Invalid values print nullText.
+func format(f fmt.State, verb rune, source any, value any, valid bool, nullText string) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		fmt.Fprintf(f, "%T{value:%#v, valid:%t}", source, value, valid)
+	case verb == 'v' && f.Flag('+'):
+		fmt.Fprintf(f, "{value:%+v valid:%t}", value, valid)
+	case !valid:
+		formatNull(f, nullText)
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), value)
+	}
+}
+
+// formatNull prints nullText, honouring the width and the - flag of f.
+func formatNull(f fmt.State, nullText string) {
+	layout := "%"
+
+	if f.Flag('-') {
+		layout += "-"
+	}
+
+	if width, ok := f.Width(); ok {
+		layout += strconv.Itoa(width)
+	}
+
+	fmt.Fprintf(f, layout+"s", nullText)
+}
+
+// textString returns the text of marshalText, or an empty string if it fails.
+func textString(marshalText func() ([]byte, error)) string {
+	text, err := marshalText()
+
+	if err != nil {
+		return ZeroString
+	}
+
+	return string(text)
+}
@@ -0,0 +1,94 @@
+package null
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		format   string
+		value    any
+		expected string
+	}{
+		{"%v", IntFrom(42), "42"},
+		{"%v", NewInt(42, false), "NULL"},
+		{"%s", NewInt(42, false), "NULL"},
+		{"%6v|", NewInt(42, false), "  NULL|"},
+		{"%-6v|", NewInt(42, false), "NULL  |"},
+		{"%d", Int64From(42), "42"},
+		{"%05d", Int8From(-4), "-0004"},
+		{"%x", Uint16From(255), "ff"},
+		{"%.2f", Float64From(3.14159), "3.14"},
+		{"%.2f", NewFloat64(3.14159, false), "NULL"},
+		{"%t", BoolFrom(true), "true"},
+		{"%v", StringFrom("a"), "a"},
+		{"%v", StringFrom(ZeroString), ""},
+		{"%q", StringFrom("a b"), `"a b"`},
+		{"%q", NewString("a", false), "NULL"},
+		{"%+v", IntFrom(42), "{value:42 valid:true}"},
+		{"%+v", NewString("a", false), "{value:a valid:false}"},
+		{"%#v", From(42), "null.NullableImpl[int]{value:42, valid:true}"},
+		{"%#v", ByteFrom('b'), "null.Byte{value:0x62, valid:true}"},
+		{"%v", ByteFrom('b'), "b"},
+		{"%d", ByteFrom('b'), "98"},
+		{"%q", ByteFrom('b'), "'b'"},
+		{"%v", NewByte('b', false), "NULL"},
+		{"%s", BytesFrom([]byte("raw")), "raw"},
+		{"%x", BytesFrom([]byte("raw")), "726177"},
+		{"%v", JSONFrom([]byte(`{"a":1}`)), `{"a":1}`},
+		{"%v", NewJSON(nil, false), "NULL"},
+		{"%v", OptionalInt64From(Int64From(7)), "7"},
+		{"%v", OptionalString{}, "NULL"},
+		{"%v", &Float32{}, "NULL"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, fmt.Sprintf(test.format, test.value), test.format)
+	}
+}
+
+func TestFormatTimeAndUUID(t *testing.T) {
+	id := uuid.New()
+	value := time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, id.String(), fmt.Sprintf("%v", UUIDFrom(id)))
+	assert.Equal(t, id.String(), fmt.Sprint(UUIDFrom(id)))
+	assert.Equal(t, "NULL", fmt.Sprint(NewUUID(id, false)))
+	assert.Equal(t, "2024-02-29", fmt.Sprint(TimeFrom(value, WithTimeLayout(time.DateOnly))))
+	assert.Equal(t, "2024-02-29T00:00:00Z", TimeFrom(value).String())
+	assert.Equal(t, "NULL", fmt.Sprint(NewTime(value, false)))
+
+	date := TimeFrom(value, WithTimeLayout(time.DateOnly))
+	assert.Equal(t, "2024-02-29", fmt.Sprintf("%s", date))
+	assert.Equal(t, `"2024-02-29"`, fmt.Sprintf("%q", date))
+	assert.Equal(t, "2024-02-29  ", fmt.Sprintf("%-12v", date))
+	assert.Equal(t, "{value:2024-02-29 00:00:00 +0000 UTC valid:true}", fmt.Sprintf("%+v", date))
+	assert.Equal(t, "{value:0001-01-01 00:00:00 +0000 UTC valid:false}", fmt.Sprintf("%+v", Time{}))
+	assert.Equal(
+		t,
+		"null.Time{value:time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC), valid:true}",
+		fmt.Sprintf("%#v", date),
+	)
+	assert.Equal(t, "  NULL", fmt.Sprintf("%6v", Time{}))
+	assert.Equal(t, "1709164800", fmt.Sprint(TimeFrom(value, WithTimeTextEpoch(TimeEpochSeconds))))
+	assert.Equal(t, "2024-02-29", date.FormatLayout(WithTimeLayoutFormat()))
+}
+
+func TestFormatNullText(t *testing.T) {
+	t.Cleanup(func() {
+		defaultConfig.Store(nil)
+	})
+
+	config := DefaultConfig()
+	config.NullText = "<nil>"
+	SetDefaultConfig(config)
+
+	assert.Equal(t, "<nil>", fmt.Sprint(NewInt(1, false)))
+	assert.Equal(t, "<nil>", NewString("a", false).String())
+	assert.Equal(t, "<nil>", NewTime(time.Now(), false).String())
+}
@@ -53,7 +53,7 @@ func (n Int) Value() (driver.Value, error) {
 		return nil, nil
 	}
 
-	value, err := integerValuerChecker(n.value, n.valuerType)
+	value, err := integerValuerChecker(n.value, n.currentConfig().valuerType(n.valuerType))
 
 	if err != nil {
 		return value, NewValuerError(n, err)
@@ -70,3 +70,37 @@ func (n *Int) setValuerType(options ...IntegerOption) {
 		n.valuerType = integerOption.valuerType
 	}
 }
+
+// AppendBinary implements encoding.BinaryAppender.
+// The valuer type is encoded along with the value.
+func (n Int) AppendBinary(b []byte) ([]byte, error) {
+	b, err := appendIntegerBinary(b, n.NullableImpl, n.valuerType)
+
+	if err != nil {
+		return b, NewMarshalError(n, err)
+	}
+
+	return b, nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (n Int) GobEncode() ([]byte, error) {
+	return n.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (n *Int) GobDecode(data []byte) error {
+	return n.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (n Int) MarshalBinary() ([]byte, error) {
+	return n.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (n *Int) UnmarshalBinary(data []byte) (err error) {
+	n.valuerType, err = unmarshalIntegerBinary(data, &n.NullableImpl)
+
+	return err
+}
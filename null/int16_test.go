@@ -296,16 +296,24 @@ func TestInt16IsZero(t *testing.T) {
 	)
 
 	zero := NewInt16(ZeroInt16, true)
-	assert.True(
+	assert.False(
 		t,
 		zero.IsZero(),
 	)
+	assert.True(
+		t,
+		zero.IsZeroValue(),
+	)
 
 	null := NewInt16(ZeroInt16, false)
 	assert.True(
 		t,
 		null.IsZero(),
 	)
+	assert.True(
+		t,
+		null.IsNull(),
+	)
 }
 
 func TestInt16SetValue(t *testing.T) {
@@ -368,3 +376,23 @@ func TestInt16Scan(t *testing.T) {
 		null,
 	)
 }
+
+func TestInt16MarshalXML(t *testing.T) {
+	testData := newInt16Data()
+	assertXMLRoundTrip(t, Int16From(testData.Value), testData.String, Int16{})
+	assertXMLNull(t, Int16{}, Int16{})
+}
+
+func TestInt16MarshalYAML(t *testing.T) {
+	testData := newInt16Data()
+	assertYAMLRoundTrip(t, Int16From(testData.Value), testData.String, Int16{})
+	assertYAMLNull(t, Int16{}, Int16From(testData.Value))
+}
+
+func TestInt16MarshalBinary(t *testing.T) {
+	testData := newInt16Data()
+	assertBinaryRoundTrip(t, Int16From(testData.Value))
+	assertBinaryRoundTrip(t, Int16From(testData.Value, WithInt64Valuer()))
+	assertBinaryRoundTrip(t, NewInt16(0, false, WithUint8Valuer()))
+	assertBinaryRoundTrip(t, Int16{})
+}
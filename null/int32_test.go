@@ -296,16 +296,24 @@ func TestInt32IsZero(t *testing.T) {
 	)
 
 	zero := NewInt32(ZeroInt32, true)
-	assert.True(
+	assert.False(
 		t,
 		zero.IsZero(),
 	)
+	assert.True(
+		t,
+		zero.IsZeroValue(),
+	)
 
 	null := NewInt32(ZeroInt32, false)
 	assert.True(
 		t,
 		null.IsZero(),
 	)
+	assert.True(
+		t,
+		null.IsNull(),
+	)
 }
 
 func TestInt32SetValue(t *testing.T) {
@@ -368,3 +376,23 @@ func TestInt32Scan(t *testing.T) {
 		null,
 	)
 }
+
+func TestInt32MarshalXML(t *testing.T) {
+	testData := newInt32Data()
+	assertXMLRoundTrip(t, Int32From(testData.Value), testData.String, Int32{})
+	assertXMLNull(t, Int32{}, Int32{})
+}
+
+func TestInt32MarshalYAML(t *testing.T) {
+	testData := newInt32Data()
+	assertYAMLRoundTrip(t, Int32From(testData.Value), testData.String, Int32{})
+	assertYAMLNull(t, Int32{}, Int32From(testData.Value))
+}
+
+func TestInt32MarshalBinary(t *testing.T) {
+	testData := newInt32Data()
+	assertBinaryRoundTrip(t, Int32From(testData.Value))
+	assertBinaryRoundTrip(t, Int32From(testData.Value, WithInt64Valuer()))
+	assertBinaryRoundTrip(t, NewInt32(0, false, WithUint8Valuer()))
+	assertBinaryRoundTrip(t, Int32{})
+}
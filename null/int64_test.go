@@ -296,16 +296,24 @@ func TestInt64IsZero(t *testing.T) {
 	)
 
 	zero := NewInt64(ZeroInt64, true)
-	assert.True(
+	assert.False(
 		t,
 		zero.IsZero(),
 	)
+	assert.True(
+		t,
+		zero.IsZeroValue(),
+	)
 
 	null := NewInt64(ZeroInt64, false)
 	assert.True(
 		t,
 		null.IsZero(),
 	)
+	assert.True(
+		t,
+		null.IsNull(),
+	)
 }
 
 func TestInt64SetValue(t *testing.T) {
@@ -368,3 +376,23 @@ func TestInt64Scan(t *testing.T) {
 		null,
 	)
 }
+
+func TestInt64MarshalXML(t *testing.T) {
+	testData := newInt64Data()
+	assertXMLRoundTrip(t, Int64From(testData.Value), testData.String, Int64{})
+	assertXMLNull(t, Int64{}, Int64{})
+}
+
+func TestInt64MarshalYAML(t *testing.T) {
+	testData := newInt64Data()
+	assertYAMLRoundTrip(t, Int64From(testData.Value), testData.String, Int64{})
+	assertYAMLNull(t, Int64{}, Int64From(testData.Value))
+}
+
+func TestInt64MarshalBinary(t *testing.T) {
+	testData := newInt64Data()
+	assertBinaryRoundTrip(t, Int64From(testData.Value))
+	assertBinaryRoundTrip(t, Int64From(testData.Value, WithInt64Valuer()))
+	assertBinaryRoundTrip(t, NewInt64(0, false, WithUint8Valuer()))
+	assertBinaryRoundTrip(t, Int64{})
+}
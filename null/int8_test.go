@@ -296,16 +296,24 @@ func TestInt8IsZero(t *testing.T) {
 	)
 
 	zero := NewInt8(ZeroInt8, true)
-	assert.True(
+	assert.False(
 		t,
 		zero.IsZero(),
 	)
+	assert.True(
+		t,
+		zero.IsZeroValue(),
+	)
 
 	null := NewInt8(ZeroInt8, false)
 	assert.True(
 		t,
 		null.IsZero(),
 	)
+	assert.True(
+		t,
+		null.IsNull(),
+	)
 }
 
 func TestInt8SetValue(t *testing.T) {
@@ -368,3 +376,23 @@ func TestInt8Scan(t *testing.T) {
 		null,
 	)
 }
+
+func TestInt8MarshalXML(t *testing.T) {
+	testData := newInt8Data()
+	assertXMLRoundTrip(t, Int8From(testData.Value), testData.String, Int8{})
+	assertXMLNull(t, Int8{}, Int8{})
+}
+
+func TestInt8MarshalYAML(t *testing.T) {
+	testData := newInt8Data()
+	assertYAMLRoundTrip(t, Int8From(testData.Value), testData.String, Int8{})
+	assertYAMLNull(t, Int8{}, Int8From(testData.Value))
+}
+
+func TestInt8MarshalBinary(t *testing.T) {
+	testData := newInt8Data()
+	assertBinaryRoundTrip(t, Int8From(testData.Value))
+	assertBinaryRoundTrip(t, Int8From(testData.Value, WithInt64Valuer()))
+	assertBinaryRoundTrip(t, NewInt8(0, false, WithUint8Valuer()))
+	assertBinaryRoundTrip(t, Int8{})
+}
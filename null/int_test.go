@@ -296,16 +296,24 @@ func TestIntIsZero(t *testing.T) {
 	)
 
 	zero := NewInt(ZeroInt, true)
-	assert.True(
+	assert.False(
 		t,
 		zero.IsZero(),
 	)
+	assert.True(
+		t,
+		zero.IsZeroValue(),
+	)
 
 	null := NewInt(ZeroInt, false)
 	assert.True(
 		t,
 		null.IsZero(),
 	)
+	assert.True(
+		t,
+		null.IsNull(),
+	)
 }
 
 func TestIntSetValue(t *testing.T) {
@@ -368,3 +376,23 @@ func TestIntScan(t *testing.T) {
 		null,
 	)
 }
+
+func TestIntMarshalXML(t *testing.T) {
+	testData := newIntData()
+	assertXMLRoundTrip(t, IntFrom(testData.Value), testData.String, Int{})
+	assertXMLNull(t, Int{}, Int{})
+}
+
+func TestIntMarshalYAML(t *testing.T) {
+	testData := newIntData()
+	assertYAMLRoundTrip(t, IntFrom(testData.Value), testData.String, Int{})
+	assertYAMLNull(t, Int{}, IntFrom(testData.Value))
+}
+
+func TestIntMarshalBinary(t *testing.T) {
+	testData := newIntData()
+	assertBinaryRoundTrip(t, IntFrom(testData.Value))
+	assertBinaryRoundTrip(t, IntFrom(testData.Value, WithInt64Valuer()))
+	assertBinaryRoundTrip(t, NewInt(0, false, WithUint8Valuer()))
+	assertBinaryRoundTrip(t, Int{})
+}
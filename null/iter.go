@@ -0,0 +1,82 @@
+package null // import "github.com/Patrick-Batenburg/nullify/null"
+
+import (
+	"iter"
+)
+
+// Values returns an iterator that yields the inner values of the valid nullables in seq.
+// Invalid nullables are skipped.
+func Values[N Getter[T], T any](seq iter.Seq[N]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := range seq {
+			if !n.IsValid() {
+				continue
+			}
+
+			if !yield(n.ValueOrZero()) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator that yields the inner value and validity of every nullable in seq.
+// Invalid nullables yield the zero value of T and false.
+func All[N Getter[T], T any](seq iter.Seq[N]) iter.Seq2[T, bool] {
+	return func(yield func(T, bool) bool) {
+		for n := range seq {
+			if !yield(n.ValueOrZero(), n.IsValid()) {
+				return
+			}
+		}
+	}
+}
+
+// Compact returns the inner values of the valid nullables in values.
+// Invalid nullables are skipped.
+func Compact[N Getter[T], T any](values []N) []T {
+	result := make([]T, 0, len(values))
+
+	for _, n := range values {
+		if n.IsValid() {
+			result = append(result, n.ValueOrZero())
+		}
+	}
+
+	return result
+}
+
+// FromSlice returns a slice of valid NullableImpl for every value in values.
+func FromSlice[T any](values []T) []NullableImpl[T] {
+	result := make([]NullableImpl[T], len(values))
+
+	for i, value := range values {
+		result[i] = From(value)
+	}
+
+	return result
+}
+
+// FromPtrSlice returns a slice of NullableImpl for every pointer in values.
+// A nil pointer results in an invalid NullableImpl.
+func FromPtrSlice[T any](values []*T) []NullableImpl[T] {
+	result := make([]NullableImpl[T], len(values))
+
+	for i, value := range values {
+		result[i] = FromPtr(value)
+	}
+
+	return result
+}
+
+// Collect collects the value and validity pairs yielded by seq into a slice of NullableImpl.
+// It is the inverse of All.
+func Collect[T any](seq iter.Seq2[T, bool]) []NullableImpl[T] {
+	var result []NullableImpl[T]
+
+	for value, valid := range seq {
+		result = append(result, New(value, valid))
+	}
+
+	return result
+}
@@ -0,0 +1,66 @@
+package null
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValues(t *testing.T) {
+	values := []Int64{Int64From(1), NewInt64(2, false), Int64From(ZeroInt64), Int64From(3)}
+	result := slices.Collect(Values(slices.Values(values)))
+	assert.Equal(t, []int64{1, ZeroInt64, 3}, result)
+
+	var stopped []int64
+
+	for value := range Values(slices.Values(values)) {
+		stopped = append(stopped, value)
+
+		break
+	}
+
+	assert.Equal(t, []int64{1}, stopped)
+}
+
+func TestAll(t *testing.T) {
+	values := []String{StringFrom("a"), NewString("b", false)}
+	var (
+		inner []string
+		valid []bool
+	)
+
+	for value, ok := range All(slices.Values(values)) {
+		inner = append(inner, value)
+		valid = append(valid, ok)
+	}
+
+	assert.Equal(t, []string{"a", ZeroString}, inner)
+	assert.Equal(t, []bool{true, false}, valid)
+}
+
+func TestCompact(t *testing.T) {
+	values := []String{StringFrom("a"), NewString("b", false), StringFrom(ZeroString)}
+	assert.Equal(t, []string{"a", ZeroString}, Compact(values))
+	assert.Empty(t, Compact([]NullableImpl[int]{New(1, false)}))
+}
+
+func TestFromSlice(t *testing.T) {
+	assert.Equal(t, []NullableImpl[int]{From(1), From(ZeroInt)}, FromSlice([]int{1, ZeroInt}))
+	assert.Empty(t, FromSlice[int](nil))
+}
+
+func TestFromPtrSlice(t *testing.T) {
+	testData := newIntData()
+	assert.Equal(
+		t,
+		[]NullableImpl[int]{From(testData.Value), {}},
+		FromPtrSlice([]*int{testData.Ptr, nil}),
+	)
+}
+
+func TestCollect(t *testing.T) {
+	values := []NullableImpl[int]{From(1), New(ZeroInt, false), From(3)}
+	assert.Equal(t, values, Collect(All(slices.Values(values))))
+	assert.Nil(t, Collect(All(slices.Values([]Int{}))))
+}
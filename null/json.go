@@ -3,6 +3,11 @@ package null // import "github.com/Patrick-Batenburg/nullify/null"
 import (
 	"bytes"
 	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+
+	"gopkg.in/yaml.v3"
 )
 
 // JSON is a NullableImpl []byte that contains JSON.
@@ -104,9 +109,15 @@ func (n *JSON) Marshal(data any) error {
 	return nil
 }
 
+// IsZero returns true if the value is invalid (null) or empty.
+// An empty value marshals as null, so omitzero omits it like an invalid one.
+func (n JSON) IsZero() bool {
+	return !n.IsValid() || len(n.value) == 0
+}
+
 // MarshalJSON implements json.Marshaler.
 func (n JSON) MarshalJSON() ([]byte, error) {
-	if len(n.value) == 0 {
+	if !n.IsValid() || len(n.value) == 0 {
 		return NullStringBytes, nil
 	}
 
@@ -171,3 +182,115 @@ func (n *JSON) UnmarshalJSON(data []byte) error {
 
 	return nil
 }
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is the raw JSON.
+func (n *JSON) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.value = ZeroBytes
+		n.valid = false
+
+		return nil
+	}
+
+	n.value = append(n.value[0:0], text...)
+	n.valid = true
+
+	return nil
+}
+
+// MarshalXML implements xml.Marshaler. It writes the raw JSON rather than base64.
+func (n JSON) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, n.IsValid(), n.currentConfig().XMLNull, n.MarshalText)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+func (n JSON) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, n.IsValid(), n.currentConfig().XMLNull, n.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (n *JSON) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, n.UnmarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (n *JSON) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, n.UnmarshalText)
+}
+
+// MarshalYAML implements yaml.Marshaler. The JSON is written as native YAML.
+func (n JSON) MarshalYAML() (any, error) {
+	if !n.IsValid() {
+		return nil, nil
+	}
+
+	var value any
+
+	if err := json.Unmarshal(n.value, &value); err != nil {
+		return nil, NewMarshalError(n, err)
+	}
+
+	return value, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (n *JSON) UnmarshalYAML(node *yaml.Node) error {
+	if isYAMLNull(node) {
+		n.value = ZeroBytes
+		n.valid = false
+
+		return nil
+	}
+
+	var value any
+
+	if err := node.Decode(&value); err != nil {
+		return NewUnmarshalError(node.Value, n, err)
+	}
+
+	data, err := json.Marshal(value)
+
+	if err != nil {
+		return NewUnmarshalError(node.Value, n, err)
+	}
+
+	n.value = data
+	n.valid = true
+
+	return nil
+}
+
+// Set implements the flag.Value interface.
+func (n *JSON) Set(value string) error {
+	return setFlag(n, value)
+}
+
+// String implements the fmt.Stringer and flag.Value interfaces.
+func (n JSON) String() string {
+	if !n.IsValid() {
+		return n.currentConfig().NullText
+	}
+
+	return textString(n.MarshalText)
+}
+
+// LogValue implements the slog.LogValuer interface. The JSON is logged as raw JSON.
+func (n JSON) LogValue() slog.Value {
+	if !n.IsValid() {
+		return slog.AnyValue(nil)
+	}
+
+	return slog.AnyValue(json.RawMessage(n.value))
+}
+
+// Format implements the fmt.Formatter interface. %v prints the JSON as text,
+// while the other verbs are passed through to the raw bytes.
+func (n JSON) Format(f fmt.State, verb rune) {
+	if n.IsValid() && verb == 'v' && !f.Flag('#') && !f.Flag('+') {
+		fmt.Fprintf(f, fmt.FormatString(f, 's'), n.value)
+
+		return
+	}
+
+	format(f, verb, n, n.value, n.IsValid(), n.currentConfig().NullText)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestNewJSON(t *testing.T) {
@@ -129,7 +130,7 @@ func TestJSONUnmarshalAny(t *testing.T) {
 	require.NoError(t, err)
 	assert.Nil(t, jsonMap)
 
-	invalid := NewJSON(invalidJSON, false)
+	invalid := NewJSON(invalidJSON, true)
 	jsonMap = nil
 	err = invalid.Unmarshal(&jsonMap)
 	var syntaxErr *json.SyntaxError
@@ -404,12 +405,20 @@ func TestJSONIsZero(t *testing.T) {
 		t,
 		zero.IsZero(),
 	)
+	assert.True(
+		t,
+		zero.IsZeroValue(),
+	)
 
 	null := NewJSON(ZeroBytes, false)
 	assert.True(
 		t,
 		null.IsZero(),
 	)
+	assert.True(
+		t,
+		null.IsNull(),
+	)
 }
 
 func TestJSONSetValue(t *testing.T) {
@@ -479,3 +488,29 @@ func TestJSONScan(t *testing.T) {
 		null,
 	)
 }
+
+func TestJSONMarshalXML(t *testing.T) {
+	testData := newJSONData()
+	assertXMLRoundTrip(t, JSONFrom(testData.Value), testData.String, JSON{})
+	assertXMLNull(t, JSON{}, JSON{})
+}
+
+func TestJSONMarshalYAML(t *testing.T) {
+	nonzero := JSONFrom([]byte(`{"a":[1,"b"]}`))
+	data, err := yaml.Marshal(yamlDocument[JSON]{Value: nonzero})
+	require.NoError(t, err)
+	assert.Equal(t, "value:\n    a:\n        - 1\n        - b\n", string(data))
+
+	var document yamlDocument[JSON]
+	err = yaml.Unmarshal(data, &document)
+	require.NoError(t, err)
+	assert.Equal(t, nonzero, document.Value)
+
+	assertYAMLNull(t, JSON{}, JSONFrom([]byte(`{}`)))
+}
+
+func TestJSONMarshalBinary(t *testing.T) {
+	testData := newJSONData()
+	assertBinaryRoundTrip(t, JSONFrom(testData.Value))
+	assertBinaryRoundTrip(t, JSON{})
+}
@@ -43,7 +43,8 @@ type GenericNullable[T any] interface {
 	// IsValid returns true if the value is valid.
 	IsValid() bool
 
-	// IsZero returns true if the value is zero.
+	// IsZero reports whether the value is omitted by encoding/json's omitzero option.
+	// The implementing type defines when that is, such as invalid for NullableImpl.
 	IsZero() bool
 
 	// MarshalJSON implements the json.Marshaler interface.
@@ -98,7 +99,8 @@ type Nullable interface {
 	// IsValid returns true if the value is valid.
 	IsValid() bool
 
-	// IsZero returns true if the value is zero.
+	// IsZero reports whether the value is omitted by encoding/json's omitzero option.
+	// The implementing type defines when that is, such as absent for Optional.
 	IsZero() bool
 
 	// MarshalJSON implements the json.Marshaler interface.
@@ -143,6 +145,27 @@ var (
 	_ GenericNullable[uint64]    = (*Uint64)(nil)
 	_ GenericNullable[uuid.UUID] = (*UUID)(nil)
 
+	_ GenericNullable[bool]      = (*Optional[bool])(nil)
+	_ GenericNullable[bool]      = (*OptionalBool)(nil)
+	_ GenericNullable[byte]      = (*OptionalByte)(nil)
+	_ GenericNullable[[]byte]    = (*OptionalBytes)(nil)
+	_ GenericNullable[float32]   = (*OptionalFloat32)(nil)
+	_ GenericNullable[float64]   = (*OptionalFloat64)(nil)
+	_ GenericNullable[int]       = (*OptionalInt)(nil)
+	_ GenericNullable[int8]      = (*OptionalInt8)(nil)
+	_ GenericNullable[int16]     = (*OptionalInt16)(nil)
+	_ GenericNullable[int32]     = (*OptionalInt32)(nil)
+	_ GenericNullable[int64]     = (*OptionalInt64)(nil)
+	_ GenericNullable[[]byte]    = (*OptionalJSON)(nil)
+	_ GenericNullable[string]    = (*OptionalString)(nil)
+	_ GenericNullable[time.Time] = (*OptionalTime)(nil)
+	_ GenericNullable[uint]      = (*OptionalUint)(nil)
+	_ GenericNullable[uint8]     = (*OptionalUint8)(nil)
+	_ GenericNullable[uint16]    = (*OptionalUint16)(nil)
+	_ GenericNullable[uint32]    = (*OptionalUint32)(nil)
+	_ GenericNullable[uint64]    = (*OptionalUint64)(nil)
+	_ GenericNullable[uuid.UUID] = (*OptionalUUID)(nil)
+
 	_ Nullable = (*NullableImpl[bool])(nil)
 	_ Nullable = (*Bool)(nil)
 	_ Nullable = (*Byte)(nil)
@@ -163,12 +186,37 @@ var (
 	_ Nullable = (*Uint32)(nil)
 	_ Nullable = (*Uint64)(nil)
 	_ Nullable = (*UUID)(nil)
+
+	_ Nullable = (*Optional[bool])(nil)
+	_ Nullable = (*OptionalBool)(nil)
+	_ Nullable = (*OptionalByte)(nil)
+	_ Nullable = (*OptionalBytes)(nil)
+	_ Nullable = (*OptionalFloat32)(nil)
+	_ Nullable = (*OptionalFloat64)(nil)
+	_ Nullable = (*OptionalInt)(nil)
+	_ Nullable = (*OptionalInt8)(nil)
+	_ Nullable = (*OptionalInt16)(nil)
+	_ Nullable = (*OptionalInt32)(nil)
+	_ Nullable = (*OptionalInt64)(nil)
+	_ Nullable = (*OptionalJSON)(nil)
+	_ Nullable = (*OptionalString)(nil)
+	_ Nullable = (*OptionalTime)(nil)
+	_ Nullable = (*OptionalUint)(nil)
+	_ Nullable = (*OptionalUint8)(nil)
+	_ Nullable = (*OptionalUint16)(nil)
+	_ Nullable = (*OptionalUint32)(nil)
+	_ Nullable = (*OptionalUint64)(nil)
+	_ Nullable = (*OptionalUUID)(nil)
 )
 
 // NullableImpl represents a NullableImpl value of any type.
 type NullableImpl[T any] struct {
 	value T
 	valid bool
+
+	// config is the Config bound by Config.Apply, or nil to use DefaultConfig.
+	// Since it is compared by ==, values with different bound configs are not ==, see Config.Apply.
+	config *Config
 }
 
 // New creates a new NullableImpl with a specified value and validity.
@@ -207,8 +255,21 @@ func (n NullableImpl[T]) IsValid() bool {
 	return n.valid
 }
 
-// IsZero returns true if the value is zero.
+// IsNull returns true if the value is invalid (null).
+func (n NullableImpl[T]) IsNull() bool {
+	return !n.valid
+}
+
+// IsZero returns true if the value is invalid (null).
+// It is called by encoding/json's omitzero option, so invalid values are
+// omitted while valid zero values are kept.
+// Use IsZeroValue to check the inner value instead.
 func (n NullableImpl[T]) IsZero() bool {
+	return n.IsNull()
+}
+
+// IsZeroValue returns true if the inner value is the zero value of T, regardless of its validity.
+func (n NullableImpl[T]) IsZeroValue() bool {
 	var zero T
 
 	return reflect.DeepEqual(n.value, zero)
@@ -265,11 +326,7 @@ func (n NullableImpl[T]) MarshalText() ([]byte, error) {
 	case float64:
 		return []byte(strconv.FormatFloat(v, 'f', -1, 64)), nil
 	case bool:
-		if v {
-			return TrueStringBytes, nil
-		}
-
-		return FalseStringBytes, nil
+		return n.currentConfig().boolText(v), nil
 	default:
 		// Fallback to JSON marshaling for complex types
 		data, err := json.Marshal(n.value)
@@ -460,10 +517,15 @@ func (n *NullableImpl[T]) UnmarshalText(text []byte) error {
 
 		n.value = any(float64(value)).(T)
 	case bool:
-		value, err := strconv.ParseBool(stringValue)
+		value, ok := n.currentConfig().parseBool(stringValue)
 
-		if err != nil {
-			return NewUnmarshalError(v, n, err)
+		if !ok {
+			var err error
+			value, err = strconv.ParseBool(stringValue)
+
+			if err != nil {
+				return NewUnmarshalError(v, n, err)
+			}
 		}
 
 		n.value = any(value).(T)
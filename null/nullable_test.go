@@ -2,6 +2,7 @@ package null
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"math"
 	"testing"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestNullableEqual(t *testing.T) {
@@ -201,3 +203,87 @@ func TestNullableScan(t *testing.T) {
 	err = validUUID.Scan([]byte(gofakeit.UUID()))
 	require.NoError(t, err)
 }
+
+func TestNullableMarshalXML(t *testing.T) {
+	testData := newInt64Data()
+	assertXMLRoundTrip(t, From(testData.Value), testData.String, NullableImpl[int64]{})
+	assertXMLNull(t, NullableImpl[int64]{}, NullableImpl[int64]{})
+
+	var badType NullableImpl[int64]
+	err := xml.Unmarshal([]byte(`<int>:)</int>`), &badType)
+	require.ErrorIs(t, err, ErrCannotUnmarshal)
+}
+
+func TestNullableMarshalYAML(t *testing.T) {
+	testData := newInt64Data()
+	assertYAMLRoundTrip(t, From(testData.Value), testData.String, NullableImpl[int64]{})
+	assertYAMLNull(t, NullableImpl[int64]{}, From(testData.Value))
+
+	err := yaml.Unmarshal([]byte("value: true"), &yamlDocument[NullableImpl[int64]]{})
+	require.ErrorIs(t, err, ErrCannotUnmarshal)
+}
+
+func TestNullableOmitZero(t *testing.T) {
+	type document struct {
+		Bool    Bool    `json:"bool,omitzero"`
+		Bytes   Bytes   `json:"bytes,omitzero"`
+		Float64 Float64 `json:"float64,omitzero"`
+		Int64   Int64   `json:"int64,omitzero"`
+		JSON    JSON    `json:"json,omitzero"`
+		String  String  `json:"string,omitzero"`
+		Time    Time    `json:"time,omitzero"`
+		UUID    UUID    `json:"uuid,omitzero"`
+	}
+
+	data, err := json.Marshal(document{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{}`, string(data))
+
+	data, err = json.Marshal(document{
+		Bool:    BoolFrom(ZeroBool),
+		Bytes:   BytesFrom([]byte("a")),
+		Float64: Float64From(ZeroFloat64),
+		Int64:   Int64From(ZeroInt64),
+		JSON:    JSONFrom([]byte(`{}`)),
+		String:  StringFrom(ZeroString),
+		Time:    TimeFrom(ZeroTime),
+		UUID:    UUIDFrom(uuid.Nil),
+	})
+	require.NoError(t, err)
+	assert.JSONEq(
+		t,
+		`{
+			"bool": false,
+			"bytes": "YQ==",
+			"float64": 0,
+			"int64": 0,
+			"json": {},
+			"string": "",
+			"time": "0001-01-01T00:00:00Z",
+			"uuid": "00000000-0000-0000-0000-000000000000"
+		}`,
+		string(data),
+	)
+
+	data, err = json.Marshal(document{
+		Bytes: BytesFrom([]byte{}),
+		JSON:  JSONFrom([]byte{}),
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"bytes": ""}`, string(data))
+}
+
+func TestNullableMarshalBinary(t *testing.T) {
+	testData := newInt64Data()
+	assertBinaryRoundTrip(t, From(testData.Value))
+	assertBinaryRoundTrip(t, NullableImpl[int64]{})
+	assertBinaryRoundTrip(t, From(map[string]int{"a": 1, "b": 2}))
+
+	var overflow NullableImpl[int8]
+	err := overflow.UnmarshalBinary([]byte{binaryValidFlag, 0x80, 0x04})
+	require.ErrorIs(t, err, ErrValuerCheckerIntegerOverflow)
+
+	var truncated NullableImpl[float64]
+	err = truncated.UnmarshalBinary([]byte{binaryValidFlag, 0})
+	require.ErrorIs(t, err, ErrInvalidBinaryData)
+}
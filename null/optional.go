@@ -0,0 +1,169 @@
+package null // import "github.com/Patrick-Batenburg/nullify/null"
+
+import (
+	"encoding/xml"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:generate go run ../internal/cmd/optionalgen -output optional_types.go
+
+// Optional is a NullableImpl value that also records whether it was set.
+// It distinguishes three states: absent (never set), null (set to null)
+// and value (set to a valid value). This is useful for PATCH payloads,
+// where a missing key means "leave unchanged" and an explicit null means
+// "set to NULL".
+//
+// An Optional becomes set when it is decoded through json.Unmarshaler,
+// encoding.TextUnmarshaler, xml.Unmarshaler, yaml.Unmarshaler or sql.Scanner,
+// or when SetValue is called. Decode YAML through UnmarshalYAML rather than yaml.Unmarshal,
+// which skips null nodes, to tell an explicit null apart from an absent key.
+type Optional[T any] struct {
+	NullableImpl[T]
+
+	// set determines if the value was present in the decoded input.
+	set bool
+}
+
+// NewOptional creates a new Optional that is set with a specified value and validity.
+func NewOptional[T any](value T, valid bool) Optional[T] {
+	return Optional[T]{
+		NullableImpl: New(value, valid),
+		set:          true,
+	}
+}
+
+// OptionalFrom creates a new Optional that is set and always valid.
+func OptionalFrom[T any](value T) Optional[T] {
+	return NewOptional(value, true)
+}
+
+// OptionalFromPtr creates a new Optional that is set and null if the pointer is nil.
+func OptionalFromPtr[T any](ptr *T) Optional[T] {
+	return Optional[T]{
+		NullableImpl: FromPtr(ptr),
+		set:          true,
+	}
+}
+
+// IsSet returns true if the value was set, either to null or to a valid value.
+func (o Optional[T]) IsSet() bool {
+	return o.set
+}
+
+// IsNull returns true if the value was explicitly set to null.
+func (o Optional[T]) IsNull() bool {
+	return o.set && !o.IsValid()
+}
+
+// IsValue returns true if the value was set to a valid value.
+func (o Optional[T]) IsValue() bool {
+	return o.set && o.IsValid()
+}
+
+// IsZero returns true if the value is absent.
+// It is called by encoding/json's omitzero option, so absent values are
+// omitted while explicit nulls are kept.
+func (o Optional[T]) IsZero() bool {
+	return !o.set
+}
+
+// Scan implements the sql.Scanner interface.
+func (o *Optional[T]) Scan(src any) error {
+	o.set = true
+
+	return o.NullableImpl.Scan(src)
+}
+
+// SetValue sets the value and marks it as set and valid.
+func (o *Optional[T]) SetValue(value T) {
+	o.set = true
+	o.NullableImpl.SetValue(value)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	o.set = true
+
+	return o.NullableImpl.UnmarshalJSON(data)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (o *Optional[T]) UnmarshalText(text []byte) error {
+	o.set = true
+
+	return o.NullableImpl.UnmarshalText(text)
+}
+
+// Set implements the flag.Value interface and marks the value as set.
+func (o *Optional[T]) Set(value string) error {
+	o.set = true
+
+	return o.NullableImpl.Set(value)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (o *Optional[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	o.set = true
+
+	return o.NullableImpl.UnmarshalXML(d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (o *Optional[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	o.set = true
+
+	return o.NullableImpl.UnmarshalXMLAttr(attr)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// yaml.Unmarshal does not call it for null nodes, so an explicit null decodes as absent,
+// unless the YAML is decoded through the UnmarshalYAML function of this package.
+func (o *Optional[T]) UnmarshalYAML(node *yaml.Node) error {
+	o.set = true
+
+	return o.NullableImpl.UnmarshalYAML(node)
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// Whether the value is set is encoded in the flags byte.
+func (o Optional[T]) AppendBinary(b []byte) ([]byte, error) {
+	start := len(b)
+	b, err := o.NullableImpl.AppendBinary(b)
+
+	if err == nil && o.set {
+		b[start] |= binarySetFlag
+	}
+
+	return b, err
+}
+
+// GobEncode implements gob.GobEncoder.
+func (o Optional[T]) GobEncode() ([]byte, error) {
+	return o.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (o *Optional[T]) GobDecode(data []byte) error {
+	return o.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (o Optional[T]) MarshalBinary() ([]byte, error) {
+	return o.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (o *Optional[T]) UnmarshalBinary(data []byte) error {
+	o.set = len(data) > 0 && data[0]&binarySetFlag != 0
+
+	return o.NullableImpl.UnmarshalBinary(data)
+}
+
+// Unset marks the value as absent and resets it to null.
+func (o *Optional[T]) Unset() {
+	var zero T
+	o.value = zero
+	o.valid = false
+	o.set = false
+}
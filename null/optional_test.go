@@ -0,0 +1,253 @@
+package null
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+type optionalPatchPayload struct {
+	Name    Optional[string] `json:"name"`
+	Nick    OptionalString   `json:"nick"`
+	Age     OptionalInt64    `json:"age"`
+	Created OptionalTime     `json:"created"`
+	ID      OptionalUUID     `json:"id"`
+}
+
+func TestNewOptional(t *testing.T) {
+	testData := newStringData()
+	value := NewOptional(testData.Value, true)
+	assert.True(t, value.IsSet())
+	assert.True(t, value.IsValue())
+	assert.False(t, value.IsNull())
+	assert.Equal(t, testData.Value, value.MustValue())
+
+	null := NewOptional(testData.Value, false)
+	assert.True(t, null.IsSet())
+	assert.False(t, null.IsValue())
+	assert.True(t, null.IsNull())
+
+	absent := Optional[string]{}
+	assert.False(t, absent.IsSet())
+	assert.False(t, absent.IsValue())
+	assert.False(t, absent.IsNull())
+}
+
+func TestOptionalFromPtr(t *testing.T) {
+	testData := newInt64Data()
+	value := OptionalFromPtr(testData.Ptr)
+	assert.True(t, value.IsValue())
+	assert.Equal(t, testData.Value, value.MustValue())
+
+	null := OptionalFromPtr[int64](nil)
+	assert.True(t, null.IsNull())
+}
+
+func TestOptionalUnmarshalJSON(t *testing.T) {
+	timeData := newTimeData()
+	uuidData := newUUIDData()
+	data := []byte(
+		`{"name":null,"age":42,"created":` + timeData.JSON + `,"id":"` + uuidData.String + `"}`,
+	)
+
+	var payload optionalPatchPayload
+	err := json.Unmarshal(data, &payload)
+	require.NoError(t, err)
+
+	assert.True(t, payload.Name.IsNull())
+	assert.False(t, payload.Nick.IsSet())
+	assert.True(t, payload.Age.IsValue())
+	assert.Equal(t, int64(42), payload.Age.MustValue())
+	assert.True(t, payload.Created.IsValue())
+	assert.True(t, timeData.Value.Equal(payload.Created.MustValue()))
+	assert.True(t, payload.ID.IsValue())
+	assert.Equal(t, uuidData.Value, payload.ID.MustValue())
+
+	var badType OptionalInt64
+	err = json.Unmarshal(TrueStringBytes, &badType)
+	require.ErrorIs(t, err, ErrCannotUnmarshal)
+	assert.True(t, badType.IsSet())
+}
+
+func TestOptionalMarshalJSON(t *testing.T) {
+	testData := newStringData()
+	value := OptionalFrom(testData.Value)
+	data, err := json.Marshal(value)
+	require.NoError(t, err)
+	assert.Equal(t, testData.JSONString, string(data))
+
+	null := NewOptional(testData.Value, false)
+	data, err = json.Marshal(null)
+	require.NoError(t, err)
+	assert.Equal(t, NullString, string(data))
+
+	layout := "2006-01-02"
+	timeValue := OptionalTimeFrom(
+		TimeFrom(time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), WithTimeLayout(layout)),
+	)
+	data, err = json.Marshal(timeValue)
+	require.NoError(t, err)
+	assert.Equal(t, `"2024-02-29"`, string(data))
+}
+
+func TestOptionalUnmarshalText(t *testing.T) {
+	testData := newIntData()
+	var value Optional[int]
+	err := value.UnmarshalText(testData.Bytes)
+	require.NoError(t, err)
+	assert.True(t, value.IsValue())
+	assert.Equal(t, testData.Value, value.MustValue())
+
+	var null OptionalInt
+	err = null.UnmarshalText(ZeroStringBytes)
+	require.NoError(t, err)
+	assert.True(t, null.IsNull())
+}
+
+func TestOptionalScan(t *testing.T) {
+	testData := newInt64Data()
+	var value OptionalInt64
+	err := value.Scan(testData.Value)
+	require.NoError(t, err)
+	assert.True(t, value.IsValue())
+	assert.Equal(t, testData.Value, value.MustValue())
+
+	var null Optional[int64]
+	err = null.Scan(nil)
+	require.NoError(t, err)
+	assert.True(t, null.IsNull())
+}
+
+func TestOptionalSetValueAndUnset(t *testing.T) {
+	layout := "2006-01-02"
+	value := OptionalTime{Time: NewTime(ZeroTime, false, WithTimeLayout(layout))}
+	assert.False(t, value.IsSet())
+
+	value.SetValue(time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC))
+	assert.True(t, value.IsValue())
+
+	value.Unset()
+	assert.False(t, value.IsSet())
+	assert.False(t, value.IsValid())
+	assert.Equal(t, layout, value.layout)
+
+	generic := OptionalFrom(ZeroInt64)
+	generic.Unset()
+	assert.Equal(t, Optional[int64]{}, generic)
+}
+
+func TestOptionalUnmarshalXML(t *testing.T) {
+	type payload struct {
+		Name OptionalString `xml:"name"`
+		Nick OptionalString `xml:"nick"`
+		Age  Optional[int]  `xml:"age,attr"`
+	}
+
+	var value payload
+	err := xml.Unmarshal([]byte(`<payload age="42"><name></name></payload>`), &value)
+	require.NoError(t, err)
+	assert.True(t, value.Name.IsNull())
+	assert.False(t, value.Nick.IsSet())
+	assert.True(t, value.Age.IsValue())
+	assert.Equal(t, 42, value.Age.MustValue())
+}
+
+func TestOptionalUnmarshalYAML(t *testing.T) {
+	type payload struct {
+		Name OptionalString `yaml:"name"`
+		Nick OptionalString `yaml:"nick"`
+		Age  Optional[int]  `yaml:"age"`
+	}
+
+	var value payload
+	err := yaml.Unmarshal([]byte("name: John\nage: 42\n"), &value)
+	require.NoError(t, err)
+	assert.True(t, value.Name.IsValue())
+	assert.False(t, value.Nick.IsSet())
+	assert.True(t, value.Age.IsValue())
+	assert.Equal(t, 42, value.Age.MustValue())
+}
+
+func TestOptionalUnmarshalYAMLNull(t *testing.T) {
+	type payload struct {
+		Name OptionalString   `yaml:"name"`
+		Nick OptionalString   `yaml:"nick"`
+		Age  Optional[int]    `yaml:"age"`
+		Tags []OptionalString `yaml:"tags"`
+	}
+
+	data := []byte("name: ~\nage: null\ntags: [a, ~]\n")
+
+	// yaml.Unmarshal skips null nodes, so explicit nulls decode as absent.
+	var plain payload
+	err := yaml.Unmarshal(data, &plain)
+	require.NoError(t, err)
+	assert.False(t, plain.Name.IsSet())
+	assert.False(t, plain.Age.IsSet())
+	assert.Len(t, plain.Tags, 1)
+
+	var value payload
+	err = UnmarshalYAML(data, &value)
+	require.NoError(t, err)
+	assert.True(t, value.Name.IsNull())
+	assert.False(t, value.Nick.IsSet())
+	assert.True(t, value.Age.IsNull())
+	require.Len(t, value.Tags, 2)
+	assert.True(t, value.Tags[0].IsValue())
+	assert.True(t, value.Tags[1].IsNull())
+}
+
+func TestOptionalOmitZero(t *testing.T) {
+	type document struct {
+		Absent OptionalString `json:"absent,omitzero"`
+		Null   OptionalString `json:"null,omitzero"`
+		Value  Optional[int]  `json:"value,omitzero"`
+	}
+
+	data, err := json.Marshal(document{
+		Null:  OptionalStringFrom(String{}),
+		Value: OptionalFrom(ZeroInt),
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"null":null,"value":0}`, string(data))
+}
+
+func TestOptionalMarshalBinary(t *testing.T) {
+	assertBinaryRoundTrip(t, Optional[string]{})
+	assertBinaryRoundTrip(t, OptionalFrom(New(ZeroString, false)))
+	assertBinaryRoundTrip(t, OptionalFrom(From("a")))
+	assertBinaryRoundTrip(t, OptionalInt64{})
+	assertBinaryRoundTrip(t, OptionalInt64From(NewInt64(0, false, WithInt32Valuer())))
+	assertBinaryRoundTrip(t, OptionalInt64From(Int64From(42, WithInt32Valuer())))
+
+	type payload struct {
+		Absent OptionalString
+		Null   OptionalString
+		Value  OptionalTime
+	}
+
+	value := payload{
+		Null: OptionalStringFrom(String{}),
+		Value: OptionalTimeFrom(
+			TimeFrom(time.Unix(1_700_000_000, 0).UTC(), WithTimeLayout(time.DateOnly)),
+		),
+	}
+
+	var buffer bytes.Buffer
+	err := gob.NewEncoder(&buffer).Encode(value)
+	require.NoError(t, err)
+
+	var decoded payload
+	err = gob.NewDecoder(&buffer).Decode(&decoded)
+	require.NoError(t, err)
+	assert.False(t, decoded.Absent.IsSet())
+	assert.True(t, decoded.Null.IsNull())
+	assert.Equal(t, value.Value, decoded.Value)
+}
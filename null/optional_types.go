@@ -0,0 +1,2633 @@
+// Code generated by optionalgen. DO NOT EDIT.
+
+package null // import "github.com/Patrick-Batenburg/nullify/null"
+
+import (
+	"encoding/xml"
+	"time"
+
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+)
+
+// OptionalBool is a Bool that also records whether it was set.
+// See Optional for the semantics of the absent, null and value states.
+type OptionalBool struct {
+	Bool
+
+	// set determines if the value was present in the decoded input.
+	set bool
+}
+
+// OptionalBoolFrom creates a new OptionalBool that is set to the given Bool.
+func OptionalBoolFrom(value Bool) OptionalBool {
+	return OptionalBool{
+		Bool: value,
+		set:  true,
+	}
+}
+
+// IsSet returns true if the value was set, either to null or to a valid value.
+func (o OptionalBool) IsSet() bool {
+	return o.set
+}
+
+// IsNull returns true if the value was explicitly set to null.
+func (o OptionalBool) IsNull() bool {
+	return o.set && !o.IsValid()
+}
+
+// IsValue returns true if the value was set to a valid value.
+func (o OptionalBool) IsValue() bool {
+	return o.set && o.IsValid()
+}
+
+// IsZero returns true if the value is absent.
+// It is called by encoding/json's omitzero option, so absent values are
+// omitted while explicit nulls are kept.
+func (o OptionalBool) IsZero() bool {
+	return !o.set
+}
+
+// Scan implements the sql.Scanner interface.
+func (o *OptionalBool) Scan(src any) error {
+	o.set = true
+
+	return o.Bool.Scan(src)
+}
+
+// SetValue sets the value and marks it as set and valid.
+func (o *OptionalBool) SetValue(value bool) {
+	o.set = true
+	o.Bool.SetValue(value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OptionalBool) UnmarshalJSON(data []byte) error {
+	o.set = true
+
+	return o.Bool.UnmarshalJSON(data)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (o *OptionalBool) UnmarshalText(text []byte) error {
+	o.set = true
+
+	return o.Bool.UnmarshalText(text)
+}
+
+// Set implements the flag.Value interface and marks the value as set.
+func (o *OptionalBool) Set(value string) error {
+	o.set = true
+
+	return o.Bool.Set(value)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (o *OptionalBool) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	o.set = true
+
+	return o.Bool.UnmarshalXML(d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (o *OptionalBool) UnmarshalXMLAttr(attr xml.Attr) error {
+	o.set = true
+
+	return o.Bool.UnmarshalXMLAttr(attr)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// yaml.Unmarshal does not call it for null nodes, so an explicit null decodes as absent,
+// unless the YAML is decoded through the UnmarshalYAML function of this package.
+func (o *OptionalBool) UnmarshalYAML(node *yaml.Node) error {
+	o.set = true
+
+	return o.Bool.UnmarshalYAML(node)
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// Whether the value is set is encoded in the flags byte.
+func (o OptionalBool) AppendBinary(b []byte) ([]byte, error) {
+	start := len(b)
+	b, err := o.Bool.AppendBinary(b)
+
+	if err == nil && o.set {
+		b[start] |= binarySetFlag
+	}
+
+	return b, err
+}
+
+// GobEncode implements gob.GobEncoder.
+func (o OptionalBool) GobEncode() ([]byte, error) {
+	return o.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (o *OptionalBool) GobDecode(data []byte) error {
+	return o.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (o OptionalBool) MarshalBinary() ([]byte, error) {
+	return o.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (o *OptionalBool) UnmarshalBinary(data []byte) error {
+	o.set = len(data) > 0 && data[0]&binarySetFlag != 0
+
+	return o.Bool.UnmarshalBinary(data)
+}
+
+// Unset marks the value as absent and resets it to null.
+func (o *OptionalBool) Unset() {
+	o.value = ZeroBool
+	o.valid = false
+	o.set = false
+}
+
+// OptionalByte is a Byte that also records whether it was set.
+// See Optional for the semantics of the absent, null and value states.
+type OptionalByte struct {
+	Byte
+
+	// set determines if the value was present in the decoded input.
+	set bool
+}
+
+// OptionalByteFrom creates a new OptionalByte that is set to the given Byte.
+func OptionalByteFrom(value Byte) OptionalByte {
+	return OptionalByte{
+		Byte: value,
+		set:  true,
+	}
+}
+
+// IsSet returns true if the value was set, either to null or to a valid value.
+func (o OptionalByte) IsSet() bool {
+	return o.set
+}
+
+// IsNull returns true if the value was explicitly set to null.
+func (o OptionalByte) IsNull() bool {
+	return o.set && !o.IsValid()
+}
+
+// IsValue returns true if the value was set to a valid value.
+func (o OptionalByte) IsValue() bool {
+	return o.set && o.IsValid()
+}
+
+// IsZero returns true if the value is absent.
+// It is called by encoding/json's omitzero option, so absent values are
+// omitted while explicit nulls are kept.
+func (o OptionalByte) IsZero() bool {
+	return !o.set
+}
+
+// Scan implements the sql.Scanner interface.
+func (o *OptionalByte) Scan(src any) error {
+	o.set = true
+
+	return o.Byte.Scan(src)
+}
+
+// SetValue sets the value and marks it as set and valid.
+func (o *OptionalByte) SetValue(value byte) {
+	o.set = true
+	o.Byte.SetValue(value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OptionalByte) UnmarshalJSON(data []byte) error {
+	o.set = true
+
+	return o.Byte.UnmarshalJSON(data)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (o *OptionalByte) UnmarshalText(text []byte) error {
+	o.set = true
+
+	return o.Byte.UnmarshalText(text)
+}
+
+// Set implements the flag.Value interface and marks the value as set.
+func (o *OptionalByte) Set(value string) error {
+	o.set = true
+
+	return o.Byte.Set(value)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (o *OptionalByte) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	o.set = true
+
+	return o.Byte.UnmarshalXML(d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (o *OptionalByte) UnmarshalXMLAttr(attr xml.Attr) error {
+	o.set = true
+
+	return o.Byte.UnmarshalXMLAttr(attr)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// yaml.Unmarshal does not call it for null nodes, so an explicit null decodes as absent,
+// unless the YAML is decoded through the UnmarshalYAML function of this package.
+func (o *OptionalByte) UnmarshalYAML(node *yaml.Node) error {
+	o.set = true
+
+	return o.Byte.UnmarshalYAML(node)
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// Whether the value is set is encoded in the flags byte.
+func (o OptionalByte) AppendBinary(b []byte) ([]byte, error) {
+	start := len(b)
+	b, err := o.Byte.AppendBinary(b)
+
+	if err == nil && o.set {
+		b[start] |= binarySetFlag
+	}
+
+	return b, err
+}
+
+// GobEncode implements gob.GobEncoder.
+func (o OptionalByte) GobEncode() ([]byte, error) {
+	return o.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (o *OptionalByte) GobDecode(data []byte) error {
+	return o.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (o OptionalByte) MarshalBinary() ([]byte, error) {
+	return o.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (o *OptionalByte) UnmarshalBinary(data []byte) error {
+	o.set = len(data) > 0 && data[0]&binarySetFlag != 0
+
+	return o.Byte.UnmarshalBinary(data)
+}
+
+// Unset marks the value as absent and resets it to null.
+func (o *OptionalByte) Unset() {
+	o.value = ZeroByte
+	o.valid = false
+	o.set = false
+}
+
+// OptionalBytes is a Bytes that also records whether it was set.
+// See Optional for the semantics of the absent, null and value states.
+type OptionalBytes struct {
+	Bytes
+
+	// set determines if the value was present in the decoded input.
+	set bool
+}
+
+// OptionalBytesFrom creates a new OptionalBytes that is set to the given Bytes.
+func OptionalBytesFrom(value Bytes) OptionalBytes {
+	return OptionalBytes{
+		Bytes: value,
+		set:   true,
+	}
+}
+
+// IsSet returns true if the value was set, either to null or to a valid value.
+func (o OptionalBytes) IsSet() bool {
+	return o.set
+}
+
+// IsNull returns true if the value was explicitly set to null.
+func (o OptionalBytes) IsNull() bool {
+	return o.set && !o.IsValid()
+}
+
+// IsValue returns true if the value was set to a valid value.
+func (o OptionalBytes) IsValue() bool {
+	return o.set && o.IsValid()
+}
+
+// IsZero returns true if the value is absent.
+// It is called by encoding/json's omitzero option, so absent values are
+// omitted while explicit nulls are kept.
+func (o OptionalBytes) IsZero() bool {
+	return !o.set
+}
+
+// Scan implements the sql.Scanner interface.
+func (o *OptionalBytes) Scan(src any) error {
+	o.set = true
+
+	return o.Bytes.Scan(src)
+}
+
+// SetValue sets the value and marks it as set and valid.
+func (o *OptionalBytes) SetValue(value []byte) {
+	o.set = true
+	o.Bytes.SetValue(value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OptionalBytes) UnmarshalJSON(data []byte) error {
+	o.set = true
+
+	return o.Bytes.UnmarshalJSON(data)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (o *OptionalBytes) UnmarshalText(text []byte) error {
+	o.set = true
+
+	return o.Bytes.UnmarshalText(text)
+}
+
+// Set implements the flag.Value interface and marks the value as set.
+func (o *OptionalBytes) Set(value string) error {
+	o.set = true
+
+	return o.Bytes.Set(value)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (o *OptionalBytes) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	o.set = true
+
+	return o.Bytes.UnmarshalXML(d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (o *OptionalBytes) UnmarshalXMLAttr(attr xml.Attr) error {
+	o.set = true
+
+	return o.Bytes.UnmarshalXMLAttr(attr)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// yaml.Unmarshal does not call it for null nodes, so an explicit null decodes as absent,
+// unless the YAML is decoded through the UnmarshalYAML function of this package.
+func (o *OptionalBytes) UnmarshalYAML(node *yaml.Node) error {
+	o.set = true
+
+	return o.Bytes.UnmarshalYAML(node)
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// Whether the value is set is encoded in the flags byte.
+func (o OptionalBytes) AppendBinary(b []byte) ([]byte, error) {
+	start := len(b)
+	b, err := o.Bytes.AppendBinary(b)
+
+	if err == nil && o.set {
+		b[start] |= binarySetFlag
+	}
+
+	return b, err
+}
+
+// GobEncode implements gob.GobEncoder.
+func (o OptionalBytes) GobEncode() ([]byte, error) {
+	return o.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (o *OptionalBytes) GobDecode(data []byte) error {
+	return o.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (o OptionalBytes) MarshalBinary() ([]byte, error) {
+	return o.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (o *OptionalBytes) UnmarshalBinary(data []byte) error {
+	o.set = len(data) > 0 && data[0]&binarySetFlag != 0
+
+	return o.Bytes.UnmarshalBinary(data)
+}
+
+// Unset marks the value as absent and resets it to null.
+func (o *OptionalBytes) Unset() {
+	o.value = ZeroBytes
+	o.valid = false
+	o.set = false
+}
+
+// OptionalFloat32 is a Float32 that also records whether it was set.
+// See Optional for the semantics of the absent, null and value states.
+type OptionalFloat32 struct {
+	Float32
+
+	// set determines if the value was present in the decoded input.
+	set bool
+}
+
+// OptionalFloat32From creates a new OptionalFloat32 that is set to the given Float32.
+func OptionalFloat32From(value Float32) OptionalFloat32 {
+	return OptionalFloat32{
+		Float32: value,
+		set:     true,
+	}
+}
+
+// IsSet returns true if the value was set, either to null or to a valid value.
+func (o OptionalFloat32) IsSet() bool {
+	return o.set
+}
+
+// IsNull returns true if the value was explicitly set to null.
+func (o OptionalFloat32) IsNull() bool {
+	return o.set && !o.IsValid()
+}
+
+// IsValue returns true if the value was set to a valid value.
+func (o OptionalFloat32) IsValue() bool {
+	return o.set && o.IsValid()
+}
+
+// IsZero returns true if the value is absent.
+// It is called by encoding/json's omitzero option, so absent values are
+// omitted while explicit nulls are kept.
+func (o OptionalFloat32) IsZero() bool {
+	return !o.set
+}
+
+// Scan implements the sql.Scanner interface.
+func (o *OptionalFloat32) Scan(src any) error {
+	o.set = true
+
+	return o.Float32.Scan(src)
+}
+
+// SetValue sets the value and marks it as set and valid.
+func (o *OptionalFloat32) SetValue(value float32) {
+	o.set = true
+	o.Float32.SetValue(value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OptionalFloat32) UnmarshalJSON(data []byte) error {
+	o.set = true
+
+	return o.Float32.UnmarshalJSON(data)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (o *OptionalFloat32) UnmarshalText(text []byte) error {
+	o.set = true
+
+	return o.Float32.UnmarshalText(text)
+}
+
+// Set implements the flag.Value interface and marks the value as set.
+func (o *OptionalFloat32) Set(value string) error {
+	o.set = true
+
+	return o.Float32.Set(value)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (o *OptionalFloat32) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	o.set = true
+
+	return o.Float32.UnmarshalXML(d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (o *OptionalFloat32) UnmarshalXMLAttr(attr xml.Attr) error {
+	o.set = true
+
+	return o.Float32.UnmarshalXMLAttr(attr)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// yaml.Unmarshal does not call it for null nodes, so an explicit null decodes as absent,
+// unless the YAML is decoded through the UnmarshalYAML function of this package.
+func (o *OptionalFloat32) UnmarshalYAML(node *yaml.Node) error {
+	o.set = true
+
+	return o.Float32.UnmarshalYAML(node)
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// Whether the value is set is encoded in the flags byte.
+func (o OptionalFloat32) AppendBinary(b []byte) ([]byte, error) {
+	start := len(b)
+	b, err := o.Float32.AppendBinary(b)
+
+	if err == nil && o.set {
+		b[start] |= binarySetFlag
+	}
+
+	return b, err
+}
+
+// GobEncode implements gob.GobEncoder.
+func (o OptionalFloat32) GobEncode() ([]byte, error) {
+	return o.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (o *OptionalFloat32) GobDecode(data []byte) error {
+	return o.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (o OptionalFloat32) MarshalBinary() ([]byte, error) {
+	return o.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (o *OptionalFloat32) UnmarshalBinary(data []byte) error {
+	o.set = len(data) > 0 && data[0]&binarySetFlag != 0
+
+	return o.Float32.UnmarshalBinary(data)
+}
+
+// Unset marks the value as absent and resets it to null.
+func (o *OptionalFloat32) Unset() {
+	o.value = ZeroFloat32
+	o.valid = false
+	o.set = false
+}
+
+// OptionalFloat64 is a Float64 that also records whether it was set.
+// See Optional for the semantics of the absent, null and value states.
+type OptionalFloat64 struct {
+	Float64
+
+	// set determines if the value was present in the decoded input.
+	set bool
+}
+
+// OptionalFloat64From creates a new OptionalFloat64 that is set to the given Float64.
+func OptionalFloat64From(value Float64) OptionalFloat64 {
+	return OptionalFloat64{
+		Float64: value,
+		set:     true,
+	}
+}
+
+// IsSet returns true if the value was set, either to null or to a valid value.
+func (o OptionalFloat64) IsSet() bool {
+	return o.set
+}
+
+// IsNull returns true if the value was explicitly set to null.
+func (o OptionalFloat64) IsNull() bool {
+	return o.set && !o.IsValid()
+}
+
+// IsValue returns true if the value was set to a valid value.
+func (o OptionalFloat64) IsValue() bool {
+	return o.set && o.IsValid()
+}
+
+// IsZero returns true if the value is absent.
+// It is called by encoding/json's omitzero option, so absent values are
+// omitted while explicit nulls are kept.
+func (o OptionalFloat64) IsZero() bool {
+	return !o.set
+}
+
+// Scan implements the sql.Scanner interface.
+func (o *OptionalFloat64) Scan(src any) error {
+	o.set = true
+
+	return o.Float64.Scan(src)
+}
+
+// SetValue sets the value and marks it as set and valid.
+func (o *OptionalFloat64) SetValue(value float64) {
+	o.set = true
+	o.Float64.SetValue(value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OptionalFloat64) UnmarshalJSON(data []byte) error {
+	o.set = true
+
+	return o.Float64.UnmarshalJSON(data)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (o *OptionalFloat64) UnmarshalText(text []byte) error {
+	o.set = true
+
+	return o.Float64.UnmarshalText(text)
+}
+
+// Set implements the flag.Value interface and marks the value as set.
+func (o *OptionalFloat64) Set(value string) error {
+	o.set = true
+
+	return o.Float64.Set(value)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (o *OptionalFloat64) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	o.set = true
+
+	return o.Float64.UnmarshalXML(d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (o *OptionalFloat64) UnmarshalXMLAttr(attr xml.Attr) error {
+	o.set = true
+
+	return o.Float64.UnmarshalXMLAttr(attr)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// yaml.Unmarshal does not call it for null nodes, so an explicit null decodes as absent,
+// unless the YAML is decoded through the UnmarshalYAML function of this package.
+func (o *OptionalFloat64) UnmarshalYAML(node *yaml.Node) error {
+	o.set = true
+
+	return o.Float64.UnmarshalYAML(node)
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// Whether the value is set is encoded in the flags byte.
+func (o OptionalFloat64) AppendBinary(b []byte) ([]byte, error) {
+	start := len(b)
+	b, err := o.Float64.AppendBinary(b)
+
+	if err == nil && o.set {
+		b[start] |= binarySetFlag
+	}
+
+	return b, err
+}
+
+// GobEncode implements gob.GobEncoder.
+func (o OptionalFloat64) GobEncode() ([]byte, error) {
+	return o.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (o *OptionalFloat64) GobDecode(data []byte) error {
+	return o.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (o OptionalFloat64) MarshalBinary() ([]byte, error) {
+	return o.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (o *OptionalFloat64) UnmarshalBinary(data []byte) error {
+	o.set = len(data) > 0 && data[0]&binarySetFlag != 0
+
+	return o.Float64.UnmarshalBinary(data)
+}
+
+// Unset marks the value as absent and resets it to null.
+func (o *OptionalFloat64) Unset() {
+	o.value = ZeroFloat64
+	o.valid = false
+	o.set = false
+}
+
+// OptionalInt is an Int that also records whether it was set.
+// See Optional for the semantics of the absent, null and value states.
+type OptionalInt struct {
+	Int
+
+	// set determines if the value was present in the decoded input.
+	set bool
+}
+
+// OptionalIntFrom creates a new OptionalInt that is set to the given Int.
+func OptionalIntFrom(value Int) OptionalInt {
+	return OptionalInt{
+		Int: value,
+		set: true,
+	}
+}
+
+// IsSet returns true if the value was set, either to null or to a valid value.
+func (o OptionalInt) IsSet() bool {
+	return o.set
+}
+
+// IsNull returns true if the value was explicitly set to null.
+func (o OptionalInt) IsNull() bool {
+	return o.set && !o.IsValid()
+}
+
+// IsValue returns true if the value was set to a valid value.
+func (o OptionalInt) IsValue() bool {
+	return o.set && o.IsValid()
+}
+
+// IsZero returns true if the value is absent.
+// It is called by encoding/json's omitzero option, so absent values are
+// omitted while explicit nulls are kept.
+func (o OptionalInt) IsZero() bool {
+	return !o.set
+}
+
+// Scan implements the sql.Scanner interface.
+func (o *OptionalInt) Scan(src any) error {
+	o.set = true
+
+	return o.Int.Scan(src)
+}
+
+// SetValue sets the value and marks it as set and valid.
+func (o *OptionalInt) SetValue(value int) {
+	o.set = true
+	o.Int.SetValue(value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OptionalInt) UnmarshalJSON(data []byte) error {
+	o.set = true
+
+	return o.Int.UnmarshalJSON(data)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (o *OptionalInt) UnmarshalText(text []byte) error {
+	o.set = true
+
+	return o.Int.UnmarshalText(text)
+}
+
+// Set implements the flag.Value interface and marks the value as set.
+func (o *OptionalInt) Set(value string) error {
+	o.set = true
+
+	return o.Int.Set(value)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (o *OptionalInt) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	o.set = true
+
+	return o.Int.UnmarshalXML(d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (o *OptionalInt) UnmarshalXMLAttr(attr xml.Attr) error {
+	o.set = true
+
+	return o.Int.UnmarshalXMLAttr(attr)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// yaml.Unmarshal does not call it for null nodes, so an explicit null decodes as absent,
+// unless the YAML is decoded through the UnmarshalYAML function of this package.
+func (o *OptionalInt) UnmarshalYAML(node *yaml.Node) error {
+	o.set = true
+
+	return o.Int.UnmarshalYAML(node)
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// Whether the value is set is encoded in the flags byte.
+func (o OptionalInt) AppendBinary(b []byte) ([]byte, error) {
+	start := len(b)
+	b, err := o.Int.AppendBinary(b)
+
+	if err == nil && o.set {
+		b[start] |= binarySetFlag
+	}
+
+	return b, err
+}
+
+// GobEncode implements gob.GobEncoder.
+func (o OptionalInt) GobEncode() ([]byte, error) {
+	return o.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (o *OptionalInt) GobDecode(data []byte) error {
+	return o.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (o OptionalInt) MarshalBinary() ([]byte, error) {
+	return o.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (o *OptionalInt) UnmarshalBinary(data []byte) error {
+	o.set = len(data) > 0 && data[0]&binarySetFlag != 0
+
+	return o.Int.UnmarshalBinary(data)
+}
+
+// Unset marks the value as absent and resets it to null.
+func (o *OptionalInt) Unset() {
+	o.value = ZeroInt
+	o.valid = false
+	o.set = false
+}
+
+// OptionalInt8 is an Int8 that also records whether it was set.
+// See Optional for the semantics of the absent, null and value states.
+type OptionalInt8 struct {
+	Int8
+
+	// set determines if the value was present in the decoded input.
+	set bool
+}
+
+// OptionalInt8From creates a new OptionalInt8 that is set to the given Int8.
+func OptionalInt8From(value Int8) OptionalInt8 {
+	return OptionalInt8{
+		Int8: value,
+		set:  true,
+	}
+}
+
+// IsSet returns true if the value was set, either to null or to a valid value.
+func (o OptionalInt8) IsSet() bool {
+	return o.set
+}
+
+// IsNull returns true if the value was explicitly set to null.
+func (o OptionalInt8) IsNull() bool {
+	return o.set && !o.IsValid()
+}
+
+// IsValue returns true if the value was set to a valid value.
+func (o OptionalInt8) IsValue() bool {
+	return o.set && o.IsValid()
+}
+
+// IsZero returns true if the value is absent.
+// It is called by encoding/json's omitzero option, so absent values are
+// omitted while explicit nulls are kept.
+func (o OptionalInt8) IsZero() bool {
+	return !o.set
+}
+
+// Scan implements the sql.Scanner interface.
+func (o *OptionalInt8) Scan(src any) error {
+	o.set = true
+
+	return o.Int8.Scan(src)
+}
+
+// SetValue sets the value and marks it as set and valid.
+func (o *OptionalInt8) SetValue(value int8) {
+	o.set = true
+	o.Int8.SetValue(value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OptionalInt8) UnmarshalJSON(data []byte) error {
+	o.set = true
+
+	return o.Int8.UnmarshalJSON(data)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (o *OptionalInt8) UnmarshalText(text []byte) error {
+	o.set = true
+
+	return o.Int8.UnmarshalText(text)
+}
+
+// Set implements the flag.Value interface and marks the value as set.
+func (o *OptionalInt8) Set(value string) error {
+	o.set = true
+
+	return o.Int8.Set(value)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (o *OptionalInt8) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	o.set = true
+
+	return o.Int8.UnmarshalXML(d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (o *OptionalInt8) UnmarshalXMLAttr(attr xml.Attr) error {
+	o.set = true
+
+	return o.Int8.UnmarshalXMLAttr(attr)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// yaml.Unmarshal does not call it for null nodes, so an explicit null decodes as absent,
+// unless the YAML is decoded through the UnmarshalYAML function of this package.
+func (o *OptionalInt8) UnmarshalYAML(node *yaml.Node) error {
+	o.set = true
+
+	return o.Int8.UnmarshalYAML(node)
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// Whether the value is set is encoded in the flags byte.
+func (o OptionalInt8) AppendBinary(b []byte) ([]byte, error) {
+	start := len(b)
+	b, err := o.Int8.AppendBinary(b)
+
+	if err == nil && o.set {
+		b[start] |= binarySetFlag
+	}
+
+	return b, err
+}
+
+// GobEncode implements gob.GobEncoder.
+func (o OptionalInt8) GobEncode() ([]byte, error) {
+	return o.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (o *OptionalInt8) GobDecode(data []byte) error {
+	return o.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (o OptionalInt8) MarshalBinary() ([]byte, error) {
+	return o.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (o *OptionalInt8) UnmarshalBinary(data []byte) error {
+	o.set = len(data) > 0 && data[0]&binarySetFlag != 0
+
+	return o.Int8.UnmarshalBinary(data)
+}
+
+// Unset marks the value as absent and resets it to null.
+func (o *OptionalInt8) Unset() {
+	o.value = ZeroInt8
+	o.valid = false
+	o.set = false
+}
+
+// OptionalInt16 is an Int16 that also records whether it was set.
+// See Optional for the semantics of the absent, null and value states.
+type OptionalInt16 struct {
+	Int16
+
+	// set determines if the value was present in the decoded input.
+	set bool
+}
+
+// OptionalInt16From creates a new OptionalInt16 that is set to the given Int16.
+func OptionalInt16From(value Int16) OptionalInt16 {
+	return OptionalInt16{
+		Int16: value,
+		set:   true,
+	}
+}
+
+// IsSet returns true if the value was set, either to null or to a valid value.
+func (o OptionalInt16) IsSet() bool {
+	return o.set
+}
+
+// IsNull returns true if the value was explicitly set to null.
+func (o OptionalInt16) IsNull() bool {
+	return o.set && !o.IsValid()
+}
+
+// IsValue returns true if the value was set to a valid value.
+func (o OptionalInt16) IsValue() bool {
+	return o.set && o.IsValid()
+}
+
+// IsZero returns true if the value is absent.
+// It is called by encoding/json's omitzero option, so absent values are
+// omitted while explicit nulls are kept.
+func (o OptionalInt16) IsZero() bool {
+	return !o.set
+}
+
+// Scan implements the sql.Scanner interface.
+func (o *OptionalInt16) Scan(src any) error {
+	o.set = true
+
+	return o.Int16.Scan(src)
+}
+
+// SetValue sets the value and marks it as set and valid.
+func (o *OptionalInt16) SetValue(value int16) {
+	o.set = true
+	o.Int16.SetValue(value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OptionalInt16) UnmarshalJSON(data []byte) error {
+	o.set = true
+
+	return o.Int16.UnmarshalJSON(data)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (o *OptionalInt16) UnmarshalText(text []byte) error {
+	o.set = true
+
+	return o.Int16.UnmarshalText(text)
+}
+
+// Set implements the flag.Value interface and marks the value as set.
+func (o *OptionalInt16) Set(value string) error {
+	o.set = true
+
+	return o.Int16.Set(value)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (o *OptionalInt16) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	o.set = true
+
+	return o.Int16.UnmarshalXML(d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (o *OptionalInt16) UnmarshalXMLAttr(attr xml.Attr) error {
+	o.set = true
+
+	return o.Int16.UnmarshalXMLAttr(attr)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// yaml.Unmarshal does not call it for null nodes, so an explicit null decodes as absent,
+// unless the YAML is decoded through the UnmarshalYAML function of this package.
+func (o *OptionalInt16) UnmarshalYAML(node *yaml.Node) error {
+	o.set = true
+
+	return o.Int16.UnmarshalYAML(node)
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// Whether the value is set is encoded in the flags byte.
+func (o OptionalInt16) AppendBinary(b []byte) ([]byte, error) {
+	start := len(b)
+	b, err := o.Int16.AppendBinary(b)
+
+	if err == nil && o.set {
+		b[start] |= binarySetFlag
+	}
+
+	return b, err
+}
+
+// GobEncode implements gob.GobEncoder.
+func (o OptionalInt16) GobEncode() ([]byte, error) {
+	return o.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (o *OptionalInt16) GobDecode(data []byte) error {
+	return o.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (o OptionalInt16) MarshalBinary() ([]byte, error) {
+	return o.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (o *OptionalInt16) UnmarshalBinary(data []byte) error {
+	o.set = len(data) > 0 && data[0]&binarySetFlag != 0
+
+	return o.Int16.UnmarshalBinary(data)
+}
+
+// Unset marks the value as absent and resets it to null.
+func (o *OptionalInt16) Unset() {
+	o.value = ZeroInt16
+	o.valid = false
+	o.set = false
+}
+
+// OptionalInt32 is an Int32 that also records whether it was set.
+// See Optional for the semantics of the absent, null and value states.
+type OptionalInt32 struct {
+	Int32
+
+	// set determines if the value was present in the decoded input.
+	set bool
+}
+
+// OptionalInt32From creates a new OptionalInt32 that is set to the given Int32.
+func OptionalInt32From(value Int32) OptionalInt32 {
+	return OptionalInt32{
+		Int32: value,
+		set:   true,
+	}
+}
+
+// IsSet returns true if the value was set, either to null or to a valid value.
+func (o OptionalInt32) IsSet() bool {
+	return o.set
+}
+
+// IsNull returns true if the value was explicitly set to null.
+func (o OptionalInt32) IsNull() bool {
+	return o.set && !o.IsValid()
+}
+
+// IsValue returns true if the value was set to a valid value.
+func (o OptionalInt32) IsValue() bool {
+	return o.set && o.IsValid()
+}
+
+// IsZero returns true if the value is absent.
+// It is called by encoding/json's omitzero option, so absent values are
+// omitted while explicit nulls are kept.
+func (o OptionalInt32) IsZero() bool {
+	return !o.set
+}
+
+// Scan implements the sql.Scanner interface.
+func (o *OptionalInt32) Scan(src any) error {
+	o.set = true
+
+	return o.Int32.Scan(src)
+}
+
+// SetValue sets the value and marks it as set and valid.
+func (o *OptionalInt32) SetValue(value int32) {
+	o.set = true
+	o.Int32.SetValue(value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OptionalInt32) UnmarshalJSON(data []byte) error {
+	o.set = true
+
+	return o.Int32.UnmarshalJSON(data)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (o *OptionalInt32) UnmarshalText(text []byte) error {
+	o.set = true
+
+	return o.Int32.UnmarshalText(text)
+}
+
+// Set implements the flag.Value interface and marks the value as set.
+func (o *OptionalInt32) Set(value string) error {
+	o.set = true
+
+	return o.Int32.Set(value)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (o *OptionalInt32) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	o.set = true
+
+	return o.Int32.UnmarshalXML(d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (o *OptionalInt32) UnmarshalXMLAttr(attr xml.Attr) error {
+	o.set = true
+
+	return o.Int32.UnmarshalXMLAttr(attr)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// yaml.Unmarshal does not call it for null nodes, so an explicit null decodes as absent,
+// unless the YAML is decoded through the UnmarshalYAML function of this package.
+func (o *OptionalInt32) UnmarshalYAML(node *yaml.Node) error {
+	o.set = true
+
+	return o.Int32.UnmarshalYAML(node)
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// Whether the value is set is encoded in the flags byte.
+func (o OptionalInt32) AppendBinary(b []byte) ([]byte, error) {
+	start := len(b)
+	b, err := o.Int32.AppendBinary(b)
+
+	if err == nil && o.set {
+		b[start] |= binarySetFlag
+	}
+
+	return b, err
+}
+
+// GobEncode implements gob.GobEncoder.
+func (o OptionalInt32) GobEncode() ([]byte, error) {
+	return o.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (o *OptionalInt32) GobDecode(data []byte) error {
+	return o.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (o OptionalInt32) MarshalBinary() ([]byte, error) {
+	return o.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (o *OptionalInt32) UnmarshalBinary(data []byte) error {
+	o.set = len(data) > 0 && data[0]&binarySetFlag != 0
+
+	return o.Int32.UnmarshalBinary(data)
+}
+
+// Unset marks the value as absent and resets it to null.
+func (o *OptionalInt32) Unset() {
+	o.value = ZeroInt32
+	o.valid = false
+	o.set = false
+}
+
+// OptionalInt64 is an Int64 that also records whether it was set.
+// See Optional for the semantics of the absent, null and value states.
+type OptionalInt64 struct {
+	Int64
+
+	// set determines if the value was present in the decoded input.
+	set bool
+}
+
+// OptionalInt64From creates a new OptionalInt64 that is set to the given Int64.
+func OptionalInt64From(value Int64) OptionalInt64 {
+	return OptionalInt64{
+		Int64: value,
+		set:   true,
+	}
+}
+
+// IsSet returns true if the value was set, either to null or to a valid value.
+func (o OptionalInt64) IsSet() bool {
+	return o.set
+}
+
+// IsNull returns true if the value was explicitly set to null.
+func (o OptionalInt64) IsNull() bool {
+	return o.set && !o.IsValid()
+}
+
+// IsValue returns true if the value was set to a valid value.
+func (o OptionalInt64) IsValue() bool {
+	return o.set && o.IsValid()
+}
+
+// IsZero returns true if the value is absent.
+// It is called by encoding/json's omitzero option, so absent values are
+// omitted while explicit nulls are kept.
+func (o OptionalInt64) IsZero() bool {
+	return !o.set
+}
+
+// Scan implements the sql.Scanner interface.
+func (o *OptionalInt64) Scan(src any) error {
+	o.set = true
+
+	return o.Int64.Scan(src)
+}
+
+// SetValue sets the value and marks it as set and valid.
+func (o *OptionalInt64) SetValue(value int64) {
+	o.set = true
+	o.Int64.SetValue(value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OptionalInt64) UnmarshalJSON(data []byte) error {
+	o.set = true
+
+	return o.Int64.UnmarshalJSON(data)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (o *OptionalInt64) UnmarshalText(text []byte) error {
+	o.set = true
+
+	return o.Int64.UnmarshalText(text)
+}
+
+// Set implements the flag.Value interface and marks the value as set.
+func (o *OptionalInt64) Set(value string) error {
+	o.set = true
+
+	return o.Int64.Set(value)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (o *OptionalInt64) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	o.set = true
+
+	return o.Int64.UnmarshalXML(d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (o *OptionalInt64) UnmarshalXMLAttr(attr xml.Attr) error {
+	o.set = true
+
+	return o.Int64.UnmarshalXMLAttr(attr)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// yaml.Unmarshal does not call it for null nodes, so an explicit null decodes as absent,
+// unless the YAML is decoded through the UnmarshalYAML function of this package.
+func (o *OptionalInt64) UnmarshalYAML(node *yaml.Node) error {
+	o.set = true
+
+	return o.Int64.UnmarshalYAML(node)
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// Whether the value is set is encoded in the flags byte.
+func (o OptionalInt64) AppendBinary(b []byte) ([]byte, error) {
+	start := len(b)
+	b, err := o.Int64.AppendBinary(b)
+
+	if err == nil && o.set {
+		b[start] |= binarySetFlag
+	}
+
+	return b, err
+}
+
+// GobEncode implements gob.GobEncoder.
+func (o OptionalInt64) GobEncode() ([]byte, error) {
+	return o.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (o *OptionalInt64) GobDecode(data []byte) error {
+	return o.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (o OptionalInt64) MarshalBinary() ([]byte, error) {
+	return o.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (o *OptionalInt64) UnmarshalBinary(data []byte) error {
+	o.set = len(data) > 0 && data[0]&binarySetFlag != 0
+
+	return o.Int64.UnmarshalBinary(data)
+}
+
+// Unset marks the value as absent and resets it to null.
+func (o *OptionalInt64) Unset() {
+	o.value = ZeroInt64
+	o.valid = false
+	o.set = false
+}
+
+// OptionalJSON is a JSON that also records whether it was set.
+// See Optional for the semantics of the absent, null and value states.
+type OptionalJSON struct {
+	JSON
+
+	// set determines if the value was present in the decoded input.
+	set bool
+}
+
+// OptionalJSONFrom creates a new OptionalJSON that is set to the given JSON.
+func OptionalJSONFrom(value JSON) OptionalJSON {
+	return OptionalJSON{
+		JSON: value,
+		set:  true,
+	}
+}
+
+// IsSet returns true if the value was set, either to null or to a valid value.
+func (o OptionalJSON) IsSet() bool {
+	return o.set
+}
+
+// IsNull returns true if the value was explicitly set to null.
+func (o OptionalJSON) IsNull() bool {
+	return o.set && !o.IsValid()
+}
+
+// IsValue returns true if the value was set to a valid value.
+func (o OptionalJSON) IsValue() bool {
+	return o.set && o.IsValid()
+}
+
+// IsZero returns true if the value is absent.
+// It is called by encoding/json's omitzero option, so absent values are
+// omitted while explicit nulls are kept.
+func (o OptionalJSON) IsZero() bool {
+	return !o.set
+}
+
+// Scan implements the sql.Scanner interface.
+func (o *OptionalJSON) Scan(src any) error {
+	o.set = true
+
+	return o.JSON.Scan(src)
+}
+
+// SetValue sets the value and marks it as set and valid.
+func (o *OptionalJSON) SetValue(value []byte) {
+	o.set = true
+	o.JSON.SetValue(value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OptionalJSON) UnmarshalJSON(data []byte) error {
+	o.set = true
+
+	return o.JSON.UnmarshalJSON(data)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (o *OptionalJSON) UnmarshalText(text []byte) error {
+	o.set = true
+
+	return o.JSON.UnmarshalText(text)
+}
+
+// Set implements the flag.Value interface and marks the value as set.
+func (o *OptionalJSON) Set(value string) error {
+	o.set = true
+
+	return o.JSON.Set(value)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (o *OptionalJSON) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	o.set = true
+
+	return o.JSON.UnmarshalXML(d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (o *OptionalJSON) UnmarshalXMLAttr(attr xml.Attr) error {
+	o.set = true
+
+	return o.JSON.UnmarshalXMLAttr(attr)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// yaml.Unmarshal does not call it for null nodes, so an explicit null decodes as absent,
+// unless the YAML is decoded through the UnmarshalYAML function of this package.
+func (o *OptionalJSON) UnmarshalYAML(node *yaml.Node) error {
+	o.set = true
+
+	return o.JSON.UnmarshalYAML(node)
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// Whether the value is set is encoded in the flags byte.
+func (o OptionalJSON) AppendBinary(b []byte) ([]byte, error) {
+	start := len(b)
+	b, err := o.JSON.AppendBinary(b)
+
+	if err == nil && o.set {
+		b[start] |= binarySetFlag
+	}
+
+	return b, err
+}
+
+// GobEncode implements gob.GobEncoder.
+func (o OptionalJSON) GobEncode() ([]byte, error) {
+	return o.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (o *OptionalJSON) GobDecode(data []byte) error {
+	return o.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (o OptionalJSON) MarshalBinary() ([]byte, error) {
+	return o.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (o *OptionalJSON) UnmarshalBinary(data []byte) error {
+	o.set = len(data) > 0 && data[0]&binarySetFlag != 0
+
+	return o.JSON.UnmarshalBinary(data)
+}
+
+// Unset marks the value as absent and resets it to null.
+func (o *OptionalJSON) Unset() {
+	o.value = ZeroBytes
+	o.valid = false
+	o.set = false
+}
+
+// OptionalString is a String that also records whether it was set.
+// See Optional for the semantics of the absent, null and value states.
+type OptionalString struct {
+	String
+
+	// set determines if the value was present in the decoded input.
+	set bool
+}
+
+// OptionalStringFrom creates a new OptionalString that is set to the given String.
+func OptionalStringFrom(value String) OptionalString {
+	return OptionalString{
+		String: value,
+		set:    true,
+	}
+}
+
+// IsSet returns true if the value was set, either to null or to a valid value.
+func (o OptionalString) IsSet() bool {
+	return o.set
+}
+
+// IsNull returns true if the value was explicitly set to null.
+func (o OptionalString) IsNull() bool {
+	return o.set && !o.IsValid()
+}
+
+// IsValue returns true if the value was set to a valid value.
+func (o OptionalString) IsValue() bool {
+	return o.set && o.IsValid()
+}
+
+// IsZero returns true if the value is absent.
+// It is called by encoding/json's omitzero option, so absent values are
+// omitted while explicit nulls are kept.
+func (o OptionalString) IsZero() bool {
+	return !o.set
+}
+
+// Scan implements the sql.Scanner interface.
+func (o *OptionalString) Scan(src any) error {
+	o.set = true
+
+	return o.String.Scan(src)
+}
+
+// SetValue sets the value and marks it as set and valid.
+func (o *OptionalString) SetValue(value string) {
+	o.set = true
+	o.String.SetValue(value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OptionalString) UnmarshalJSON(data []byte) error {
+	o.set = true
+
+	return o.String.UnmarshalJSON(data)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (o *OptionalString) UnmarshalText(text []byte) error {
+	o.set = true
+
+	return o.String.UnmarshalText(text)
+}
+
+// Set implements the flag.Value interface and marks the value as set.
+func (o *OptionalString) Set(value string) error {
+	o.set = true
+
+	return o.String.Set(value)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (o *OptionalString) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	o.set = true
+
+	return o.String.UnmarshalXML(d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (o *OptionalString) UnmarshalXMLAttr(attr xml.Attr) error {
+	o.set = true
+
+	return o.String.UnmarshalXMLAttr(attr)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// yaml.Unmarshal does not call it for null nodes, so an explicit null decodes as absent,
+// unless the YAML is decoded through the UnmarshalYAML function of this package.
+func (o *OptionalString) UnmarshalYAML(node *yaml.Node) error {
+	o.set = true
+
+	return o.String.UnmarshalYAML(node)
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// Whether the value is set is encoded in the flags byte.
+func (o OptionalString) AppendBinary(b []byte) ([]byte, error) {
+	start := len(b)
+	b, err := o.String.AppendBinary(b)
+
+	if err == nil && o.set {
+		b[start] |= binarySetFlag
+	}
+
+	return b, err
+}
+
+// GobEncode implements gob.GobEncoder.
+func (o OptionalString) GobEncode() ([]byte, error) {
+	return o.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (o *OptionalString) GobDecode(data []byte) error {
+	return o.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (o OptionalString) MarshalBinary() ([]byte, error) {
+	return o.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (o *OptionalString) UnmarshalBinary(data []byte) error {
+	o.set = len(data) > 0 && data[0]&binarySetFlag != 0
+
+	return o.String.UnmarshalBinary(data)
+}
+
+// Unset marks the value as absent and resets it to null.
+func (o *OptionalString) Unset() {
+	o.value = ZeroString
+	o.valid = false
+	o.set = false
+}
+
+// OptionalTime is a Time that also records whether it was set.
+// See Optional for the semantics of the absent, null and value states.
+type OptionalTime struct {
+	Time
+
+	// set determines if the value was present in the decoded input.
+	set bool
+}
+
+// OptionalTimeFrom creates a new OptionalTime that is set to the given Time.
+func OptionalTimeFrom(value Time) OptionalTime {
+	return OptionalTime{
+		Time: value,
+		set:  true,
+	}
+}
+
+// IsSet returns true if the value was set, either to null or to a valid value.
+func (o OptionalTime) IsSet() bool {
+	return o.set
+}
+
+// IsNull returns true if the value was explicitly set to null.
+func (o OptionalTime) IsNull() bool {
+	return o.set && !o.IsValid()
+}
+
+// IsValue returns true if the value was set to a valid value.
+func (o OptionalTime) IsValue() bool {
+	return o.set && o.IsValid()
+}
+
+// IsZero returns true if the value is absent.
+// It is called by encoding/json's omitzero option, so absent values are
+// omitted while explicit nulls are kept.
+func (o OptionalTime) IsZero() bool {
+	return !o.set
+}
+
+// Scan implements the sql.Scanner interface.
+func (o *OptionalTime) Scan(src any) error {
+	o.set = true
+
+	return o.Time.Scan(src)
+}
+
+// SetValue sets the value and marks it as set and valid.
+func (o *OptionalTime) SetValue(value time.Time) {
+	o.set = true
+	o.Time.SetValue(value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OptionalTime) UnmarshalJSON(data []byte) error {
+	o.set = true
+
+	return o.Time.UnmarshalJSON(data)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (o *OptionalTime) UnmarshalText(text []byte) error {
+	o.set = true
+
+	return o.Time.UnmarshalText(text)
+}
+
+// Set implements the flag.Value interface and marks the value as set.
+func (o *OptionalTime) Set(value string) error {
+	o.set = true
+
+	return o.Time.Set(value)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (o *OptionalTime) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	o.set = true
+
+	return o.Time.UnmarshalXML(d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (o *OptionalTime) UnmarshalXMLAttr(attr xml.Attr) error {
+	o.set = true
+
+	return o.Time.UnmarshalXMLAttr(attr)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// yaml.Unmarshal does not call it for null nodes, so an explicit null decodes as absent,
+// unless the YAML is decoded through the UnmarshalYAML function of this package.
+func (o *OptionalTime) UnmarshalYAML(node *yaml.Node) error {
+	o.set = true
+
+	return o.Time.UnmarshalYAML(node)
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// Whether the value is set is encoded in the flags byte.
+func (o OptionalTime) AppendBinary(b []byte) ([]byte, error) {
+	start := len(b)
+	b, err := o.Time.AppendBinary(b)
+
+	if err == nil && o.set {
+		b[start] |= binarySetFlag
+	}
+
+	return b, err
+}
+
+// GobEncode implements gob.GobEncoder.
+func (o OptionalTime) GobEncode() ([]byte, error) {
+	return o.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (o *OptionalTime) GobDecode(data []byte) error {
+	return o.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (o OptionalTime) MarshalBinary() ([]byte, error) {
+	return o.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (o *OptionalTime) UnmarshalBinary(data []byte) error {
+	o.set = len(data) > 0 && data[0]&binarySetFlag != 0
+
+	return o.Time.UnmarshalBinary(data)
+}
+
+// Unset marks the value as absent and resets it to null.
+func (o *OptionalTime) Unset() {
+	o.value = ZeroTime
+	o.valid = false
+	o.set = false
+}
+
+// OptionalUint is a Uint that also records whether it was set.
+// See Optional for the semantics of the absent, null and value states.
+type OptionalUint struct {
+	Uint
+
+	// set determines if the value was present in the decoded input.
+	set bool
+}
+
+// OptionalUintFrom creates a new OptionalUint that is set to the given Uint.
+func OptionalUintFrom(value Uint) OptionalUint {
+	return OptionalUint{
+		Uint: value,
+		set:  true,
+	}
+}
+
+// IsSet returns true if the value was set, either to null or to a valid value.
+func (o OptionalUint) IsSet() bool {
+	return o.set
+}
+
+// IsNull returns true if the value was explicitly set to null.
+func (o OptionalUint) IsNull() bool {
+	return o.set && !o.IsValid()
+}
+
+// IsValue returns true if the value was set to a valid value.
+func (o OptionalUint) IsValue() bool {
+	return o.set && o.IsValid()
+}
+
+// IsZero returns true if the value is absent.
+// It is called by encoding/json's omitzero option, so absent values are
+// omitted while explicit nulls are kept.
+func (o OptionalUint) IsZero() bool {
+	return !o.set
+}
+
+// Scan implements the sql.Scanner interface.
+func (o *OptionalUint) Scan(src any) error {
+	o.set = true
+
+	return o.Uint.Scan(src)
+}
+
+// SetValue sets the value and marks it as set and valid.
+func (o *OptionalUint) SetValue(value uint) {
+	o.set = true
+	o.Uint.SetValue(value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OptionalUint) UnmarshalJSON(data []byte) error {
+	o.set = true
+
+	return o.Uint.UnmarshalJSON(data)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (o *OptionalUint) UnmarshalText(text []byte) error {
+	o.set = true
+
+	return o.Uint.UnmarshalText(text)
+}
+
+// Set implements the flag.Value interface and marks the value as set.
+func (o *OptionalUint) Set(value string) error {
+	o.set = true
+
+	return o.Uint.Set(value)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (o *OptionalUint) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	o.set = true
+
+	return o.Uint.UnmarshalXML(d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (o *OptionalUint) UnmarshalXMLAttr(attr xml.Attr) error {
+	o.set = true
+
+	return o.Uint.UnmarshalXMLAttr(attr)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// yaml.Unmarshal does not call it for null nodes, so an explicit null decodes as absent,
+// unless the YAML is decoded through the UnmarshalYAML function of this package.
+func (o *OptionalUint) UnmarshalYAML(node *yaml.Node) error {
+	o.set = true
+
+	return o.Uint.UnmarshalYAML(node)
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// Whether the value is set is encoded in the flags byte.
+func (o OptionalUint) AppendBinary(b []byte) ([]byte, error) {
+	start := len(b)
+	b, err := o.Uint.AppendBinary(b)
+
+	if err == nil && o.set {
+		b[start] |= binarySetFlag
+	}
+
+	return b, err
+}
+
+// GobEncode implements gob.GobEncoder.
+func (o OptionalUint) GobEncode() ([]byte, error) {
+	return o.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (o *OptionalUint) GobDecode(data []byte) error {
+	return o.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (o OptionalUint) MarshalBinary() ([]byte, error) {
+	return o.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (o *OptionalUint) UnmarshalBinary(data []byte) error {
+	o.set = len(data) > 0 && data[0]&binarySetFlag != 0
+
+	return o.Uint.UnmarshalBinary(data)
+}
+
+// Unset marks the value as absent and resets it to null.
+func (o *OptionalUint) Unset() {
+	o.value = ZeroUint
+	o.valid = false
+	o.set = false
+}
+
+// OptionalUint8 is a Uint8 that also records whether it was set.
+// See Optional for the semantics of the absent, null and value states.
+type OptionalUint8 struct {
+	Uint8
+
+	// set determines if the value was present in the decoded input.
+	set bool
+}
+
+// OptionalUint8From creates a new OptionalUint8 that is set to the given Uint8.
+func OptionalUint8From(value Uint8) OptionalUint8 {
+	return OptionalUint8{
+		Uint8: value,
+		set:   true,
+	}
+}
+
+// IsSet returns true if the value was set, either to null or to a valid value.
+func (o OptionalUint8) IsSet() bool {
+	return o.set
+}
+
+// IsNull returns true if the value was explicitly set to null.
+func (o OptionalUint8) IsNull() bool {
+	return o.set && !o.IsValid()
+}
+
+// IsValue returns true if the value was set to a valid value.
+func (o OptionalUint8) IsValue() bool {
+	return o.set && o.IsValid()
+}
+
+// IsZero returns true if the value is absent.
+// It is called by encoding/json's omitzero option, so absent values are
+// omitted while explicit nulls are kept.
+func (o OptionalUint8) IsZero() bool {
+	return !o.set
+}
+
+// Scan implements the sql.Scanner interface.
+func (o *OptionalUint8) Scan(src any) error {
+	o.set = true
+
+	return o.Uint8.Scan(src)
+}
+
+// SetValue sets the value and marks it as set and valid.
+func (o *OptionalUint8) SetValue(value uint8) {
+	o.set = true
+	o.Uint8.SetValue(value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OptionalUint8) UnmarshalJSON(data []byte) error {
+	o.set = true
+
+	return o.Uint8.UnmarshalJSON(data)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (o *OptionalUint8) UnmarshalText(text []byte) error {
+	o.set = true
+
+	return o.Uint8.UnmarshalText(text)
+}
+
+// Set implements the flag.Value interface and marks the value as set.
+func (o *OptionalUint8) Set(value string) error {
+	o.set = true
+
+	return o.Uint8.Set(value)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (o *OptionalUint8) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	o.set = true
+
+	return o.Uint8.UnmarshalXML(d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (o *OptionalUint8) UnmarshalXMLAttr(attr xml.Attr) error {
+	o.set = true
+
+	return o.Uint8.UnmarshalXMLAttr(attr)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// yaml.Unmarshal does not call it for null nodes, so an explicit null decodes as absent,
+// unless the YAML is decoded through the UnmarshalYAML function of this package.
+func (o *OptionalUint8) UnmarshalYAML(node *yaml.Node) error {
+	o.set = true
+
+	return o.Uint8.UnmarshalYAML(node)
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// Whether the value is set is encoded in the flags byte.
+func (o OptionalUint8) AppendBinary(b []byte) ([]byte, error) {
+	start := len(b)
+	b, err := o.Uint8.AppendBinary(b)
+
+	if err == nil && o.set {
+		b[start] |= binarySetFlag
+	}
+
+	return b, err
+}
+
+// GobEncode implements gob.GobEncoder.
+func (o OptionalUint8) GobEncode() ([]byte, error) {
+	return o.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (o *OptionalUint8) GobDecode(data []byte) error {
+	return o.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (o OptionalUint8) MarshalBinary() ([]byte, error) {
+	return o.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (o *OptionalUint8) UnmarshalBinary(data []byte) error {
+	o.set = len(data) > 0 && data[0]&binarySetFlag != 0
+
+	return o.Uint8.UnmarshalBinary(data)
+}
+
+// Unset marks the value as absent and resets it to null.
+func (o *OptionalUint8) Unset() {
+	o.value = ZeroUint8
+	o.valid = false
+	o.set = false
+}
+
+// OptionalUint16 is a Uint16 that also records whether it was set.
+// See Optional for the semantics of the absent, null and value states.
+type OptionalUint16 struct {
+	Uint16
+
+	// set determines if the value was present in the decoded input.
+	set bool
+}
+
+// OptionalUint16From creates a new OptionalUint16 that is set to the given Uint16.
+func OptionalUint16From(value Uint16) OptionalUint16 {
+	return OptionalUint16{
+		Uint16: value,
+		set:    true,
+	}
+}
+
+// IsSet returns true if the value was set, either to null or to a valid value.
+func (o OptionalUint16) IsSet() bool {
+	return o.set
+}
+
+// IsNull returns true if the value was explicitly set to null.
+func (o OptionalUint16) IsNull() bool {
+	return o.set && !o.IsValid()
+}
+
+// IsValue returns true if the value was set to a valid value.
+func (o OptionalUint16) IsValue() bool {
+	return o.set && o.IsValid()
+}
+
+// IsZero returns true if the value is absent.
+// It is called by encoding/json's omitzero option, so absent values are
+// omitted while explicit nulls are kept.
+func (o OptionalUint16) IsZero() bool {
+	return !o.set
+}
+
+// Scan implements the sql.Scanner interface.
+func (o *OptionalUint16) Scan(src any) error {
+	o.set = true
+
+	return o.Uint16.Scan(src)
+}
+
+// SetValue sets the value and marks it as set and valid.
+func (o *OptionalUint16) SetValue(value uint16) {
+	o.set = true
+	o.Uint16.SetValue(value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OptionalUint16) UnmarshalJSON(data []byte) error {
+	o.set = true
+
+	return o.Uint16.UnmarshalJSON(data)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (o *OptionalUint16) UnmarshalText(text []byte) error {
+	o.set = true
+
+	return o.Uint16.UnmarshalText(text)
+}
+
+// Set implements the flag.Value interface and marks the value as set.
+func (o *OptionalUint16) Set(value string) error {
+	o.set = true
+
+	return o.Uint16.Set(value)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (o *OptionalUint16) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	o.set = true
+
+	return o.Uint16.UnmarshalXML(d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (o *OptionalUint16) UnmarshalXMLAttr(attr xml.Attr) error {
+	o.set = true
+
+	return o.Uint16.UnmarshalXMLAttr(attr)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// yaml.Unmarshal does not call it for null nodes, so an explicit null decodes as absent,
+// unless the YAML is decoded through the UnmarshalYAML function of this package.
+func (o *OptionalUint16) UnmarshalYAML(node *yaml.Node) error {
+	o.set = true
+
+	return o.Uint16.UnmarshalYAML(node)
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// Whether the value is set is encoded in the flags byte.
+func (o OptionalUint16) AppendBinary(b []byte) ([]byte, error) {
+	start := len(b)
+	b, err := o.Uint16.AppendBinary(b)
+
+	if err == nil && o.set {
+		b[start] |= binarySetFlag
+	}
+
+	return b, err
+}
+
+// GobEncode implements gob.GobEncoder.
+func (o OptionalUint16) GobEncode() ([]byte, error) {
+	return o.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (o *OptionalUint16) GobDecode(data []byte) error {
+	return o.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (o OptionalUint16) MarshalBinary() ([]byte, error) {
+	return o.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (o *OptionalUint16) UnmarshalBinary(data []byte) error {
+	o.set = len(data) > 0 && data[0]&binarySetFlag != 0
+
+	return o.Uint16.UnmarshalBinary(data)
+}
+
+// Unset marks the value as absent and resets it to null.
+func (o *OptionalUint16) Unset() {
+	o.value = ZeroUint16
+	o.valid = false
+	o.set = false
+}
+
+// OptionalUint32 is a Uint32 that also records whether it was set.
+// See Optional for the semantics of the absent, null and value states.
+type OptionalUint32 struct {
+	Uint32
+
+	// set determines if the value was present in the decoded input.
+	set bool
+}
+
+// OptionalUint32From creates a new OptionalUint32 that is set to the given Uint32.
+func OptionalUint32From(value Uint32) OptionalUint32 {
+	return OptionalUint32{
+		Uint32: value,
+		set:    true,
+	}
+}
+
+// IsSet returns true if the value was set, either to null or to a valid value.
+func (o OptionalUint32) IsSet() bool {
+	return o.set
+}
+
+// IsNull returns true if the value was explicitly set to null.
+func (o OptionalUint32) IsNull() bool {
+	return o.set && !o.IsValid()
+}
+
+// IsValue returns true if the value was set to a valid value.
+func (o OptionalUint32) IsValue() bool {
+	return o.set && o.IsValid()
+}
+
+// IsZero returns true if the value is absent.
+// It is called by encoding/json's omitzero option, so absent values are
+// omitted while explicit nulls are kept.
+func (o OptionalUint32) IsZero() bool {
+	return !o.set
+}
+
+// Scan implements the sql.Scanner interface.
+func (o *OptionalUint32) Scan(src any) error {
+	o.set = true
+
+	return o.Uint32.Scan(src)
+}
+
+// SetValue sets the value and marks it as set and valid.
+func (o *OptionalUint32) SetValue(value uint32) {
+	o.set = true
+	o.Uint32.SetValue(value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OptionalUint32) UnmarshalJSON(data []byte) error {
+	o.set = true
+
+	return o.Uint32.UnmarshalJSON(data)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (o *OptionalUint32) UnmarshalText(text []byte) error {
+	o.set = true
+
+	return o.Uint32.UnmarshalText(text)
+}
+
+// Set implements the flag.Value interface and marks the value as set.
+func (o *OptionalUint32) Set(value string) error {
+	o.set = true
+
+	return o.Uint32.Set(value)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (o *OptionalUint32) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	o.set = true
+
+	return o.Uint32.UnmarshalXML(d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (o *OptionalUint32) UnmarshalXMLAttr(attr xml.Attr) error {
+	o.set = true
+
+	return o.Uint32.UnmarshalXMLAttr(attr)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// yaml.Unmarshal does not call it for null nodes, so an explicit null decodes as absent,
+// unless the YAML is decoded through the UnmarshalYAML function of this package.
+func (o *OptionalUint32) UnmarshalYAML(node *yaml.Node) error {
+	o.set = true
+
+	return o.Uint32.UnmarshalYAML(node)
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// Whether the value is set is encoded in the flags byte.
+func (o OptionalUint32) AppendBinary(b []byte) ([]byte, error) {
+	start := len(b)
+	b, err := o.Uint32.AppendBinary(b)
+
+	if err == nil && o.set {
+		b[start] |= binarySetFlag
+	}
+
+	return b, err
+}
+
+// GobEncode implements gob.GobEncoder.
+func (o OptionalUint32) GobEncode() ([]byte, error) {
+	return o.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (o *OptionalUint32) GobDecode(data []byte) error {
+	return o.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (o OptionalUint32) MarshalBinary() ([]byte, error) {
+	return o.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (o *OptionalUint32) UnmarshalBinary(data []byte) error {
+	o.set = len(data) > 0 && data[0]&binarySetFlag != 0
+
+	return o.Uint32.UnmarshalBinary(data)
+}
+
+// Unset marks the value as absent and resets it to null.
+func (o *OptionalUint32) Unset() {
+	o.value = ZeroUint32
+	o.valid = false
+	o.set = false
+}
+
+// OptionalUint64 is a Uint64 that also records whether it was set.
+// See Optional for the semantics of the absent, null and value states.
+type OptionalUint64 struct {
+	Uint64
+
+	// set determines if the value was present in the decoded input.
+	set bool
+}
+
+// OptionalUint64From creates a new OptionalUint64 that is set to the given Uint64.
+func OptionalUint64From(value Uint64) OptionalUint64 {
+	return OptionalUint64{
+		Uint64: value,
+		set:    true,
+	}
+}
+
+// IsSet returns true if the value was set, either to null or to a valid value.
+func (o OptionalUint64) IsSet() bool {
+	return o.set
+}
+
+// IsNull returns true if the value was explicitly set to null.
+func (o OptionalUint64) IsNull() bool {
+	return o.set && !o.IsValid()
+}
+
+// IsValue returns true if the value was set to a valid value.
+func (o OptionalUint64) IsValue() bool {
+	return o.set && o.IsValid()
+}
+
+// IsZero returns true if the value is absent.
+// It is called by encoding/json's omitzero option, so absent values are
+// omitted while explicit nulls are kept.
+func (o OptionalUint64) IsZero() bool {
+	return !o.set
+}
+
+// Scan implements the sql.Scanner interface.
+func (o *OptionalUint64) Scan(src any) error {
+	o.set = true
+
+	return o.Uint64.Scan(src)
+}
+
+// SetValue sets the value and marks it as set and valid.
+func (o *OptionalUint64) SetValue(value uint64) {
+	o.set = true
+	o.Uint64.SetValue(value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OptionalUint64) UnmarshalJSON(data []byte) error {
+	o.set = true
+
+	return o.Uint64.UnmarshalJSON(data)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (o *OptionalUint64) UnmarshalText(text []byte) error {
+	o.set = true
+
+	return o.Uint64.UnmarshalText(text)
+}
+
+// Set implements the flag.Value interface and marks the value as set.
+func (o *OptionalUint64) Set(value string) error {
+	o.set = true
+
+	return o.Uint64.Set(value)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (o *OptionalUint64) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	o.set = true
+
+	return o.Uint64.UnmarshalXML(d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (o *OptionalUint64) UnmarshalXMLAttr(attr xml.Attr) error {
+	o.set = true
+
+	return o.Uint64.UnmarshalXMLAttr(attr)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// yaml.Unmarshal does not call it for null nodes, so an explicit null decodes as absent,
+// unless the YAML is decoded through the UnmarshalYAML function of this package.
+func (o *OptionalUint64) UnmarshalYAML(node *yaml.Node) error {
+	o.set = true
+
+	return o.Uint64.UnmarshalYAML(node)
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// Whether the value is set is encoded in the flags byte.
+func (o OptionalUint64) AppendBinary(b []byte) ([]byte, error) {
+	start := len(b)
+	b, err := o.Uint64.AppendBinary(b)
+
+	if err == nil && o.set {
+		b[start] |= binarySetFlag
+	}
+
+	return b, err
+}
+
+// GobEncode implements gob.GobEncoder.
+func (o OptionalUint64) GobEncode() ([]byte, error) {
+	return o.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (o *OptionalUint64) GobDecode(data []byte) error {
+	return o.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (o OptionalUint64) MarshalBinary() ([]byte, error) {
+	return o.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (o *OptionalUint64) UnmarshalBinary(data []byte) error {
+	o.set = len(data) > 0 && data[0]&binarySetFlag != 0
+
+	return o.Uint64.UnmarshalBinary(data)
+}
+
+// Unset marks the value as absent and resets it to null.
+func (o *OptionalUint64) Unset() {
+	o.value = ZeroUint64
+	o.valid = false
+	o.set = false
+}
+
+// OptionalUUID is a UUID that also records whether it was set.
+// See Optional for the semantics of the absent, null and value states.
+type OptionalUUID struct {
+	UUID
+
+	// set determines if the value was present in the decoded input.
+	set bool
+}
+
+// OptionalUUIDFrom creates a new OptionalUUID that is set to the given UUID.
+func OptionalUUIDFrom(value UUID) OptionalUUID {
+	return OptionalUUID{
+		UUID: value,
+		set:  true,
+	}
+}
+
+// IsSet returns true if the value was set, either to null or to a valid value.
+func (o OptionalUUID) IsSet() bool {
+	return o.set
+}
+
+// IsNull returns true if the value was explicitly set to null.
+func (o OptionalUUID) IsNull() bool {
+	return o.set && !o.IsValid()
+}
+
+// IsValue returns true if the value was set to a valid value.
+func (o OptionalUUID) IsValue() bool {
+	return o.set && o.IsValid()
+}
+
+// IsZero returns true if the value is absent.
+// It is called by encoding/json's omitzero option, so absent values are
+// omitted while explicit nulls are kept.
+func (o OptionalUUID) IsZero() bool {
+	return !o.set
+}
+
+// Scan implements the sql.Scanner interface.
+func (o *OptionalUUID) Scan(src any) error {
+	o.set = true
+
+	return o.UUID.Scan(src)
+}
+
+// SetValue sets the value and marks it as set and valid.
+func (o *OptionalUUID) SetValue(value uuid.UUID) {
+	o.set = true
+	o.UUID.SetValue(value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OptionalUUID) UnmarshalJSON(data []byte) error {
+	o.set = true
+
+	return o.UUID.UnmarshalJSON(data)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (o *OptionalUUID) UnmarshalText(text []byte) error {
+	o.set = true
+
+	return o.UUID.UnmarshalText(text)
+}
+
+// Set implements the flag.Value interface and marks the value as set.
+func (o *OptionalUUID) Set(value string) error {
+	o.set = true
+
+	return o.UUID.Set(value)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (o *OptionalUUID) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	o.set = true
+
+	return o.UUID.UnmarshalXML(d, start)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (o *OptionalUUID) UnmarshalXMLAttr(attr xml.Attr) error {
+	o.set = true
+
+	return o.UUID.UnmarshalXMLAttr(attr)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// yaml.Unmarshal does not call it for null nodes, so an explicit null decodes as absent,
+// unless the YAML is decoded through the UnmarshalYAML function of this package.
+func (o *OptionalUUID) UnmarshalYAML(node *yaml.Node) error {
+	o.set = true
+
+	return o.UUID.UnmarshalYAML(node)
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// Whether the value is set is encoded in the flags byte.
+func (o OptionalUUID) AppendBinary(b []byte) ([]byte, error) {
+	start := len(b)
+	b, err := o.UUID.AppendBinary(b)
+
+	if err == nil && o.set {
+		b[start] |= binarySetFlag
+	}
+
+	return b, err
+}
+
+// GobEncode implements gob.GobEncoder.
+func (o OptionalUUID) GobEncode() ([]byte, error) {
+	return o.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (o *OptionalUUID) GobDecode(data []byte) error {
+	return o.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (o OptionalUUID) MarshalBinary() ([]byte, error) {
+	return o.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (o *OptionalUUID) UnmarshalBinary(data []byte) error {
+	o.set = len(data) > 0 && data[0]&binarySetFlag != 0
+
+	return o.UUID.UnmarshalBinary(data)
+}
+
+// Unset marks the value as absent and resets it to null.
+func (o *OptionalUUID) Unset() {
+	o.value = uuid.Nil
+	o.valid = false
+	o.set = false
+}
@@ -0,0 +1,165 @@
+package null // import "github.com/Patrick-Batenburg/nullify/null"
+
+import (
+	"log/slog"
+	"reflect"
+	"slices"
+)
+
+var (
+	_ slog.LogValuer = (*NullableImpl[bool])(nil)
+	_ slog.LogValuer = (*Bool)(nil)
+	_ slog.LogValuer = (*Byte)(nil)
+	_ slog.LogValuer = (*Bytes)(nil)
+	_ slog.LogValuer = (*Float32)(nil)
+	_ slog.LogValuer = (*Float64)(nil)
+	_ slog.LogValuer = (*Int)(nil)
+	_ slog.LogValuer = (*Int8)(nil)
+	_ slog.LogValuer = (*Int16)(nil)
+	_ slog.LogValuer = (*Int32)(nil)
+	_ slog.LogValuer = (*Int64)(nil)
+	_ slog.LogValuer = (*JSON)(nil)
+	_ slog.LogValuer = (*String)(nil)
+	_ slog.LogValuer = (*Time)(nil)
+	_ slog.LogValuer = (*Uint)(nil)
+	_ slog.LogValuer = (*Uint8)(nil)
+	_ slog.LogValuer = (*Uint16)(nil)
+	_ slog.LogValuer = (*Uint32)(nil)
+	_ slog.LogValuer = (*Uint64)(nil)
+	_ slog.LogValuer = (*UUID)(nil)
+)
+
+var (
+	logValuerType = reflect.TypeFor[slog.LogValuer]()
+	validatorType = reflect.TypeFor[interface{ IsValid() bool }]()
+)
+
+// LogValue implements the slog.LogValuer interface.
+// Invalid values are logged as nil, which handlers render as null, and
+// valid values are logged as their native kind, such as slog.Int64Value or slog.TimeValue.
+func (n NullableImpl[T]) LogValue() slog.Value {
+	if !n.IsValid() {
+		return slog.AnyValue(nil)
+	}
+
+	return slog.AnyValue(n.value)
+}
+
+// ReplaceAttrOptionFn is a type alias for a function that modifies the options of NewReplaceAttr.
+type ReplaceAttrOptionFn = func(*replaceAttrOption)
+
+// replaceAttrOption holds the options of NewReplaceAttr.
+type replaceAttrOption struct {
+	redactedKeys []string
+}
+
+// WithRedactedKeys redacts valid values of attributes and struct fields with one of keys.
+// They are replaced by the RedactedText of DefaultConfig, while invalid values are still logged as null.
+func WithRedactedKeys(keys ...string) ReplaceAttrOptionFn {
+	return func(option *replaceAttrOption) {
+		option.redactedKeys = append(option.redactedKeys, keys...)
+	}
+}
+
+// ReplaceAttr is a slog.HandlerOptions.ReplaceAttr function that expands structs holding
+// nullable fields, at any depth, into groups, so that the fields are logged through their LogValue.
+// Without it, such structs are logged as a whole by the handler.
+func ReplaceAttr(groups []string, attr slog.Attr) slog.Attr {
+	return replaceAttr(replaceAttrOption{}, attr)
+}
+
+// NewReplaceAttr returns a ReplaceAttr function configured by options.
+func NewReplaceAttr(
+	options ...ReplaceAttrOptionFn,
+) func(groups []string, attr slog.Attr) slog.Attr {
+	var option replaceAttrOption
+
+	for _, fn := range options {
+		fn(&option)
+	}
+
+	return func(groups []string, attr slog.Attr) slog.Attr {
+		return replaceAttr(option, attr)
+	}
+}
+
+// replaceAttr redacts attr or expands its struct value into a group.
+// The handler calls it again for every attribute of the group.
+func replaceAttr(option replaceAttrOption, attr slog.Attr) slog.Attr {
+	value := attr.Value.Resolve()
+
+	if slices.Contains(option.redactedKeys, attr.Key) {
+		if value.Kind() != slog.KindAny || value.Any() != nil {
+			attr.Value = slog.StringValue(DefaultConfig().RedactedText)
+		}
+
+		return attr
+	}
+
+	if value.Kind() != slog.KindAny {
+		return attr
+	}
+
+	source := reflect.ValueOf(value.Any())
+
+	for source.Kind() == reflect.Pointer && !source.IsNil() {
+		source = source.Elem()
+	}
+
+	if source.Kind() != reflect.Struct || !hasNullableField(source.Type(), nil) {
+		return attr
+	}
+
+	var attrs []slog.Attr
+
+	for i := range source.NumField() {
+		field := source.Type().Field(i)
+
+		if field.IsExported() {
+			attrs = append(attrs, slog.Any(field.Name, source.Field(i).Interface()))
+		}
+	}
+
+	attr.Value = slog.GroupValue(attrs...)
+
+	return attr
+}
+
+// hasNullableField reports whether the struct type t has an exported nullable field at any depth.
+// visited guards against recursive types.
+func hasNullableField(t reflect.Type, visited map[reflect.Type]bool) bool {
+	if visited[t] {
+		return false
+	}
+
+	if visited == nil {
+		visited = make(map[reflect.Type]bool)
+	}
+
+	visited[t] = true
+
+	for i := range t.NumField() {
+		field := t.Field(i)
+
+		if !field.IsExported() {
+			continue
+		}
+
+		fieldType := field.Type
+
+		for fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+
+		if reflect.PointerTo(fieldType).Implements(logValuerType) &&
+			reflect.PointerTo(fieldType).Implements(validatorType) {
+			return true
+		}
+
+		if fieldType.Kind() == reflect.Struct && hasNullableField(fieldType, visited) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,147 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func logJSON(
+	t *testing.T,
+	replaceAttr func([]string, slog.Attr) slog.Attr,
+	args ...any,
+) map[string]any {
+	t.Helper()
+
+	var buffer bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buffer, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, attr slog.Attr) slog.Attr {
+			if len(groups) == 0 && (attr.Key == slog.TimeKey || attr.Key == slog.LevelKey) {
+				return slog.Attr{}
+			}
+
+			if replaceAttr != nil {
+				return replaceAttr(groups, attr)
+			}
+
+			return attr
+		},
+	}))
+	logger.Info("message", args...)
+
+	var result map[string]any
+	err := json.Unmarshal(buffer.Bytes(), &result)
+	require.NoError(t, err)
+	delete(result, slog.MessageKey)
+
+	return result
+}
+
+func TestLogValue(t *testing.T) {
+	id := uuid.New()
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	assert.Equal(t, slog.KindInt64, Int8From(1).LogValue().Kind())
+	assert.Equal(t, slog.KindUint64, Uint32From(1).LogValue().Kind())
+	assert.Equal(t, slog.KindFloat64, Float32From(1).LogValue().Kind())
+	assert.Equal(t, slog.KindBool, BoolFrom(true).LogValue().Kind())
+	assert.Equal(t, slog.KindString, StringFrom("a").LogValue().Kind())
+	assert.Equal(t, slog.KindTime, TimeFrom(now).LogValue().Kind())
+	assert.Equal(t, slog.StringValue(id.String()), UUIDFrom(id).LogValue())
+	assert.Equal(t, slog.StringValue("b"), ByteFrom('b').LogValue())
+	assert.Equal(t, slog.AnyValue(nil), NewInt64(1, false).LogValue())
+
+	result := logJSON(
+		t,
+		nil,
+		"int", Int64From(42),
+		"null", NewInt64(42, false),
+		"string", StringFrom("a"),
+		"created", TimeFrom(now),
+		"uuid", UUIDFrom(id),
+		"json", JSONFrom([]byte(`{"a":1}`)),
+		"optional", OptionalFrom(From(1.5)),
+		"unset", OptionalString{},
+	)
+
+	assert.Equal(t, map[string]any{
+		"int":      float64(42),
+		"null":     nil,
+		"string":   "a",
+		"created":  now.Format(time.RFC3339),
+		"uuid":     id.String(),
+		"json":     map[string]any{"a": float64(1)},
+		"optional": 1.5,
+		"unset":    nil,
+	}, result)
+}
+
+func TestLogValueText(t *testing.T) {
+	var buffer bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buffer, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, attr slog.Attr) slog.Attr {
+			if len(groups) == 0 && attr.Key != slog.MessageKey && attr.Key[0] != 'n' {
+				return slog.Attr{}
+			}
+
+			return attr
+		},
+	}))
+	logger.Info("message", "name", StringFrom("John"), "nick", NewString("", false))
+	assert.Equal(t, "msg=message name=John nick=<nil>\n", buffer.String())
+}
+
+func TestReplaceAttr(t *testing.T) {
+	type Address struct {
+		City   String
+		Street String
+	}
+
+	type User struct {
+		ID       Int64
+		Password String
+		Token    String
+		Address  *Address
+		Tags     []string
+		secret   String
+	}
+
+	user := User{
+		ID:       Int64From(1),
+		Password: StringFrom("hunter2"),
+		Address:  &Address{City: StringFrom("Amsterdam")},
+		Tags:     []string{"a"},
+		secret:   StringFrom("hidden"),
+	}
+
+	result := logJSON(t, ReplaceAttr, "user", user, "plain", struct{ A int }{A: 1})
+	assert.Equal(t, map[string]any{
+		"user": map[string]any{
+			"ID":       float64(1),
+			"Password": "hunter2",
+			"Token":    nil,
+			"Address":  map[string]any{"City": "Amsterdam", "Street": nil},
+			"Tags":     []any{"a"},
+		},
+		"plain": map[string]any{"A": float64(1)},
+	}, result)
+
+	replaceAttr := NewReplaceAttr(WithRedactedKeys("Password", "Token", "Street", "api_key"))
+	result = logJSON(t, replaceAttr, "user", &user, "api_key", StringFrom("key"))
+	assert.Equal(t, map[string]any{
+		"user": map[string]any{
+			"ID":       float64(1),
+			"Password": DefaultConfig().RedactedText,
+			"Token":    nil,
+			"Address":  map[string]any{"City": "Amsterdam", "Street": nil},
+			"Tags":     []any{"a"},
+		},
+		"api_key": DefaultConfig().RedactedText,
+	}, result)
+}
@@ -1,28 +1,265 @@
 package null // import "github.com/Patrick-Batenburg/nullify/null"
 
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/xml"
+	"strings"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+)
+
 // String is a NullableImpl string. It supports SQL and JSON serialization.
-// It will marshal to null if null. Blank string input will be considered null.
+// It will marshal to null if null. Blank string input is a valid value,
+// unless it is considered null by WithStringEmptyAsNull or WithStringWhitespaceAsNull.
 type String struct {
 	NullableImpl[string]
+
+	// emptyAsNull determines if an empty string is considered null.
+	emptyAsNull bool
+
+	// trimSpace determines if leading and trailing white space is removed.
+	trimSpace bool
+
+	// whitespaceAsNull determines if a string that only contains white space is considered null.
+	whitespaceAsNull bool
+
+	// maxLength is the maximum length in characters when driver.Valuer is called, if positive.
+	maxLength int
+
+	// truncate determines if a string longer than maxLength is truncated instead of an error.
+	truncate bool
+
+	// stringCase determines the case the string is folded to.
+	stringCase stringCase
 }
 
 // NewString creates a new String
-func NewString(value string, valid bool) String {
-	return String{
-		NullableImpl: New(value, valid),
+func NewString(value string, valid bool, options ...StringOptionFn) String {
+	return newString(New(value, valid), options)
+}
+
+// StringFrom creates a new String that will always be valid,
+// unless the value is considered null by the options.
+func StringFrom(value string, options ...StringOptionFn) String {
+	return newString(From(value), options)
+}
+
+// StringFromPtr creates a new String that will be null if the value is nil,
+// or if the value is considered null by the options.
+func StringFromPtr(value *string, options ...StringOptionFn) String {
+	return newString(FromPtr(value), options)
+}
+
+// StringFromZero creates a new String that will be null if the value is empty.
+// The empty string is also considered null when the String is unmarshaled or scanned.
+func StringFromZero(value string, options ...StringOptionFn) String {
+	return newString(From(value), append([]StringOptionFn{WithStringEmptyAsNull()}, options...))
+}
+
+// newString creates a new String with options applied and normalizes its value.
+func newString(nullable NullableImpl[string], options []StringOptionFn) String {
+	n := &String{
+		NullableImpl: nullable,
+	}
+
+	for _, option := range options {
+		option(n)
 	}
+
+	n.normalize()
+
+	return *n
 }
 
-// StringFrom creates a new String that will always be valid.
-func StringFrom(value string) String {
-	return String{
-		NullableImpl: From(value),
+// Scan implements the sql.Scanner interface.
+func (n *String) Scan(src any) error {
+	if err := n.NullableImpl.Scan(src); err != nil {
+		return err
 	}
+
+	n.normalize()
+
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *String) UnmarshalJSON(data []byte) error {
+	if err := n.NullableImpl.UnmarshalJSON(data); err != nil {
+		return err
+	}
+
+	n.normalize()
+
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (n *String) UnmarshalText(text []byte) error {
+	if err := n.NullableImpl.UnmarshalText(text); err != nil {
+		return err
+	}
+
+	n.normalize()
+
+	return nil
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (n *String) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, n.UnmarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (n *String) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, n.UnmarshalText)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (n *String) UnmarshalYAML(node *yaml.Node) error {
+	if err := n.NullableImpl.UnmarshalYAML(node); err != nil {
+		return err
+	}
+
+	n.normalize()
+
+	return nil
+}
+
+// Set implements the flag.Value interface.
+func (n *String) Set(value string) error {
+	return setFlag(n, value)
+}
+
+// SetValue sets the value, marks it as valid and normalizes it,
+// so that it becomes null if the options consider it null.
+func (n *String) SetValue(value string) {
+	n.NullableImpl.SetValue(value)
+	n.normalize()
 }
 
-// StringFromPtr creates a new String that will be null if the value is nil.
-func StringFromPtr(value *string) String {
-	return String{
-		NullableImpl: FromPtr(value),
+// AppendBinary implements encoding.BinaryAppender.
+// The options are encoded along with the value.
+func (n String) AppendBinary(b []byte) ([]byte, error) {
+	b = appendBinaryFlags(b, n.IsValid())
+	b = appendBinaryBool(b, n.emptyAsNull)
+	b = appendBinaryBool(b, n.trimSpace)
+	b = appendBinaryBool(b, n.whitespaceAsNull)
+	b = appendBinaryBool(b, n.truncate)
+	b = append(b, byte(n.stringCase))
+	b = binary.AppendVarint(b, int64(n.maxLength))
+
+	if !n.IsValid() {
+		return b, nil
+	}
+
+	return append(b, n.value...), nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (n String) GobEncode() ([]byte, error) {
+	return n.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (n *String) GobDecode(data []byte) error {
+	return n.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (n String) MarshalBinary() ([]byte, error) {
+	return n.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (n *String) UnmarshalBinary(data []byte) error {
+	valid, rest, err := readBinaryFlags(data)
+
+	if err != nil {
+		return NewUnmarshalError(data, n, err)
+	}
+
+	decoded := *n
+	r := binaryReader{data: rest}
+	decoded.emptyAsNull = r.readBool()
+	decoded.trimSpace = r.readBool()
+	decoded.whitespaceAsNull = r.readBool()
+	decoded.truncate = r.readBool()
+	decoded.stringCase = stringCase(r.readByte(byte(stringCaseUpper)))
+	decoded.maxLength = int(r.readVarint())
+
+	if r.err != nil {
+		return NewUnmarshalError(data, n, r.err)
+	}
+
+	if err = decoded.unmarshalBinaryValue(valid, r.data); err != nil {
+		return err
+	}
+
+	*n = decoded
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+// The string is normalized and its length is checked against the maximum length.
+func (n String) Value() (driver.Value, error) {
+	n.normalize()
+
+	if !n.IsValid() {
+		return nil, nil
+	}
+
+	if n.maxLength <= 0 || utf8.RuneCountInString(n.value) <= n.maxLength {
+		return n.value, nil
+	}
+
+	if !n.truncate {
+		return nil, NewValuerError(n, ErrValuerStringTooLong)
+	}
+
+	runes := 0
+
+	for i := range n.value {
+		if runes == n.maxLength {
+			return n.value[:i], nil
+		}
+
+		runes++
+	}
+
+	return n.value, nil
+}
+
+// bindConfig binds config to the value and normalizes it, since config may consider it null.
+func (n *String) bindConfig(config *Config) {
+	n.NullableImpl.bindConfig(config)
+	n.normalize()
+}
+
+// normalize applies the options to the value and invalidates it if it is considered null.
+func (n *String) normalize() {
+	if !n.IsValid() {
+		return
+	}
+
+	if n.trimSpace {
+		n.value = strings.TrimSpace(n.value)
+	}
+
+	switch n.stringCase {
+	case stringCaseLower:
+		n.value = strings.ToLower(n.value)
+	case stringCaseUpper:
+		n.value = strings.ToUpper(n.value)
+	}
+
+	emptyAsNull := n.emptyAsNull || n.currentConfig().EmptyAsNull
+
+	if (emptyAsNull && n.value == ZeroString) ||
+		(n.whitespaceAsNull && strings.TrimSpace(n.value) == ZeroString) {
+		n.value = ZeroString
+		n.valid = false
 	}
 }
@@ -0,0 +1,70 @@
+package null // import "github.com/Patrick-Batenburg/nullify/null"
+
+// StringOptionFn is a type alias for a function that modifies a String option.
+type StringOptionFn = func(*String)
+
+// stringCase determines the case a String is folded to.
+type stringCase int
+
+const (
+	// stringCaseNone leaves the case of the String unchanged.
+	stringCaseNone stringCase = iota
+
+	// stringCaseLower folds the String to lower case.
+	stringCaseLower
+
+	// stringCaseUpper folds the String to upper case.
+	stringCaseUpper
+)
+
+// WithStringEmptyAsNull considers an empty string to be null,
+// regardless of the EmptyAsNull of the Config.
+func WithStringEmptyAsNull() StringOptionFn {
+	return func(option *String) {
+		option.emptyAsNull = true
+	}
+}
+
+// WithStringTrimSpace removes leading and trailing white space.
+func WithStringTrimSpace() StringOptionFn {
+	return func(option *String) {
+		option.trimSpace = true
+	}
+}
+
+// WithStringWhitespaceAsNull considers a string that is empty or only contains white space to be null.
+func WithStringWhitespaceAsNull() StringOptionFn {
+	return func(option *String) {
+		option.whitespaceAsNull = true
+	}
+}
+
+// WithStringMaxLength sets the maximum length in characters of the string when driver.Valuer is called.
+// A longer string results in an error, unless WithStringTruncate is used.
+func WithStringMaxLength(length int) StringOptionFn {
+	return func(option *String) {
+		option.maxLength = length
+	}
+}
+
+// WithStringTruncate truncates a string that is longer than the maximum length
+// when driver.Valuer is called, instead of returning an error.
+func WithStringTruncate() StringOptionFn {
+	return func(option *String) {
+		option.truncate = true
+	}
+}
+
+// WithStringLowerCase folds the string to lower case.
+func WithStringLowerCase() StringOptionFn {
+	return func(option *String) {
+		option.stringCase = stringCaseLower
+	}
+}
+
+// WithStringUpperCase folds the string to upper case.
+func WithStringUpperCase() StringOptionFn {
+	return func(option *String) {
+		option.stringCase = stringCaseUpper
+	}
+}
@@ -1,6 +1,8 @@
 package null
 
 import (
+	"bytes"
+	"encoding/gob"
 	"encoding/json"
 	"math"
 	"strconv"
@@ -273,16 +275,24 @@ func TestStringIsZero(t *testing.T) {
 	)
 
 	zero := NewString(ZeroString, true)
-	assert.True(
+	assert.False(
 		t,
 		zero.IsZero(),
 	)
+	assert.True(
+		t,
+		zero.IsZeroValue(),
+	)
 
 	null := NewString(ZeroString, false)
 	assert.True(
 		t,
 		null.IsZero(),
 	)
+	assert.True(
+		t,
+		null.IsNull(),
+	)
 }
 
 func TestStringSetValue(t *testing.T) {
@@ -307,6 +317,24 @@ func TestStringSetValue(t *testing.T) {
 	)
 }
 
+func TestStringSetValueNormalizes(t *testing.T) {
+	sut := NewString(
+		ZeroString,
+		false,
+		WithStringTrimSpace(),
+		WithStringLowerCase(),
+		WithStringEmptyAsNull(),
+	)
+
+	sut.SetValue("  Hello  ")
+	assert.True(t, sut.IsValid())
+	assert.Equal(t, "hello", sut.ValueOrZero())
+
+	sut.SetValue("   ")
+	assert.False(t, sut.IsValid())
+	assert.Equal(t, ZeroString, sut.ValueOrZero())
+}
+
 func TestStringScan(t *testing.T) {
 	testData := newStringData()
 	var nonzero String
@@ -345,3 +373,188 @@ func TestStringScan(t *testing.T) {
 		null,
 	)
 }
+
+func TestStringMarshalXML(t *testing.T) {
+	testData := newStringData()
+	assertXMLRoundTrip(t, StringFrom(testData.Value), testData.Value, String{})
+	assertXMLRoundTrip(t, StringFrom(`<a href="b">&</a>`), `<a href="b">&</a>`, String{})
+	assertXMLNull(t, String{}, String{})
+}
+
+func TestStringMarshalYAML(t *testing.T) {
+	testData := newStringData()
+	assertYAMLRoundTrip(t, StringFrom(testData.Value), testData.Value, String{})
+	assertYAMLRoundTrip(t, StringFrom(ZeroString), `""`, String{})
+	assertYAMLRoundTrip(t, StringFrom(NullString), `"null"`, String{})
+	assertYAMLNull(t, String{}, StringFrom(testData.Value))
+}
+
+func TestStringMarshalBinary(t *testing.T) {
+	testData := newStringData()
+	assertBinaryRoundTrip(t, StringFrom(testData.Value))
+	assertBinaryRoundTrip(t, StringFrom(ZeroString))
+	assertBinaryRoundTrip(t, String{})
+
+	options := []StringOptionFn{
+		WithStringEmptyAsNull(),
+		WithStringTrimSpace(),
+		WithStringWhitespaceAsNull(),
+		WithStringMaxLength(3),
+		WithStringTruncate(),
+		WithStringUpperCase(),
+	}
+	normalized := StringFrom(" abcd ", options...)
+	assertBinaryRoundTrip(t, normalized)
+	assertBinaryRoundTrip(t, NewString(ZeroString, false, options...))
+
+	var buffer bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buffer).Encode(NewString(ZeroString, false, options...)))
+
+	var decoded String
+	require.NoError(t, gob.NewDecoder(&buffer).Decode(&decoded))
+	assert.Equal(t, NewString(ZeroString, false, options...), decoded)
+
+	decoded.SetValue(" xyzw ")
+	value, err := decoded.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "XYZ", value)
+
+	decoded.SetValue("  ")
+	assert.False(t, decoded.IsValid())
+
+	err = decoded.UnmarshalBinary([]byte{binaryValidFlag, 0, 0, 0, 0, byte(stringCaseUpper) + 1})
+	require.ErrorIs(t, err, ErrInvalidBinaryData)
+}
+
+func TestStringFromZero(t *testing.T) {
+	testData := newStringData()
+
+	nonzero := StringFromZero(testData.Value)
+	assert.True(t, nonzero.IsValid())
+	assert.Equal(t, testData.Value, nonzero.ValueOrZero())
+
+	zero := StringFromZero(ZeroString)
+	assert.False(t, zero.IsValid())
+
+	blank := StringFromZero("  ", WithStringTrimSpace())
+	assert.False(t, blank.IsValid())
+
+	require.NoError(t, zero.UnmarshalJSON([]byte(`""`)))
+	assert.False(t, zero.IsValid())
+
+	require.NoError(t, zero.UnmarshalJSON([]byte(`"a"`)))
+	assert.True(t, zero.IsValid())
+}
+
+func TestStringOptions(t *testing.T) {
+	testCases := []struct {
+		name     string
+		options  []StringOptionFn
+		input    string
+		expected String
+	}{
+		{
+			name:  "no options",
+			input: "  Hello  ",
+			expected: String{
+				NullableImpl: NullableImpl[string]{value: "  Hello  ", valid: true},
+			},
+		},
+		{
+			name:     "empty as null",
+			options:  []StringOptionFn{WithStringEmptyAsNull()},
+			input:    ZeroString,
+			expected: String{emptyAsNull: true},
+		},
+		{
+			name:    "empty as null keeps white space",
+			options: []StringOptionFn{WithStringEmptyAsNull()},
+			input:   " ",
+			expected: String{
+				NullableImpl: NullableImpl[string]{value: " ", valid: true},
+				emptyAsNull:  true,
+			},
+		},
+		{
+			name:    "trim space",
+			options: []StringOptionFn{WithStringTrimSpace()},
+			input:   "  Hello  ",
+			expected: String{
+				NullableImpl: NullableImpl[string]{value: "Hello", valid: true},
+				trimSpace:    true,
+			},
+		},
+		{
+			name:     "whitespace as null",
+			options:  []StringOptionFn{WithStringWhitespaceAsNull()},
+			input:    " \t\n",
+			expected: String{whitespaceAsNull: true},
+		},
+		{
+			name:    "lower case",
+			options: []StringOptionFn{WithStringLowerCase()},
+			input:   "Hello",
+			expected: String{
+				NullableImpl: NullableImpl[string]{value: "hello", valid: true},
+				stringCase:   stringCaseLower,
+			},
+		},
+		{
+			name:    "upper case",
+			options: []StringOptionFn{WithStringUpperCase()},
+			input:   "Hello",
+			expected: String{
+				NullableImpl: NullableImpl[string]{value: "HELLO", valid: true},
+				stringCase:   stringCaseUpper,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, StringFrom(testCase.input, testCase.options...))
+
+			scanned := NewString(ZeroString, false, testCase.options...)
+			require.NoError(t, scanned.Scan(testCase.input))
+			assert.Equal(t, testCase.expected, scanned)
+
+			unmarshaledJSON := NewString(ZeroString, false, testCase.options...)
+			data := []byte(strconv.Quote(testCase.input))
+			require.NoError(t, unmarshaledJSON.UnmarshalJSON(data))
+			assert.Equal(t, testCase.expected, unmarshaledJSON)
+
+			if testCase.input == ZeroString {
+				return
+			}
+
+			unmarshaledText := NewString(ZeroString, false, testCase.options...)
+			require.NoError(t, unmarshaledText.UnmarshalText([]byte(testCase.input)))
+			assert.Equal(t, testCase.expected, unmarshaledText)
+		})
+	}
+}
+
+func TestStringOptionsValue(t *testing.T) {
+	n := NewString("  Héllo  ", true, WithStringTrimSpace(), WithStringMaxLength(5))
+	value, err := n.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "Héllo", value)
+
+	value, err = StringFrom("Héllo", WithStringMaxLength(2), WithStringTruncate()).Value()
+	require.NoError(t, err)
+	assert.Equal(t, "Hé", value)
+
+	_, err = StringFrom("Héllo", WithStringMaxLength(2)).Value()
+	require.ErrorIs(t, err, ErrCannotValue)
+	require.ErrorIs(t, err, ErrValuerStringTooLong)
+
+	value, err = NewString(ZeroString, false, WithStringMaxLength(2)).Value()
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	n = StringFrom("Hello", WithStringLowerCase())
+	n.SetValue("WORLD")
+	value, err = n.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "world", value)
+}
@@ -0,0 +1,535 @@
+package null // import "github.com/Patrick-Batenburg/nullify/null"
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TagName is the struct tag that configures the nullable fields of a struct,
+// such as `null:"layout=2006-01-02,lenient"`. The options are:
+//
+//   - Time: layout=<layout or name of a time layout constant, such as DateOnly>, lenient, strict,
+//     epoch=<seconds, millis, micros, nanos or float>, value=<native, layout or unix>,
+//     location=<name of a location, such as Europe/Amsterdam>, utc,
+//     valuelocation=<name of a location, such as UTC>,
+//     truncate=<duration, such as 1us> and sqlite=<text, julian or unix>.
+//     The sqlite option is applied before value and epoch, which override its value format,
+//     and location, utc and truncate convert a value that is already valid.
+//   - Int, Int8, ..., Uint64: valuer=<int, int8, int16, int32, int64, uint, uint8, uint16, uint32 or uint64>.
+//   - String: emptyasnull, whitespaceasnull, trimspace, lower, upper, maxlength=<n> and truncate.
+//   - Bytes: encoding=<base64, base64url, hex, raw or array>.
+//
+// The options of a slice, array or pointer field apply to its elements.
+const TagName = "null"
+
+var (
+	// timeLayouts maps the names of the layout constants of the time package to their layouts,
+	// so that layouts that contain a comma can be used in a tag.
+	timeLayouts = map[string]string{
+		"ANSIC":       time.ANSIC,
+		"UnixDate":    time.UnixDate,
+		"RubyDate":    time.RubyDate,
+		"RFC822":      time.RFC822,
+		"RFC822Z":     time.RFC822Z,
+		"RFC850":      time.RFC850,
+		"RFC1123":     time.RFC1123,
+		"RFC1123Z":    time.RFC1123Z,
+		"RFC3339":     time.RFC3339,
+		"RFC3339Nano": time.RFC3339Nano,
+		"Kitchen":     time.Kitchen,
+		"Stamp":       time.Stamp,
+		"StampMilli":  time.StampMilli,
+		"StampMicro":  time.StampMicro,
+		"StampNano":   time.StampNano,
+		"DateTime":    time.DateTime,
+		"DateOnly":    time.DateOnly,
+		"TimeOnly":    time.TimeOnly,
+	}
+
+	// integerValuers maps the names of the valuer tag option to their integer options.
+	integerValuers = map[string]IntegerOption{
+		"int":    WithIntValuer(),
+		"int8":   WithInt8Valuer(),
+		"int16":  WithInt16Valuer(),
+		"int32":  WithInt32Valuer(),
+		"int64":  WithInt64Valuer(),
+		"uint":   WithUintValuer(),
+		"uint8":  WithUint8Valuer(),
+		"uint16": WithUint16Valuer(),
+		"uint32": WithUint32Valuer(),
+		"uint64": WithUint64Valuer(),
+	}
+
+	// timeEpochs maps the names of the epoch tag option to their time epochs.
+	timeEpochs = map[string]TimeEpoch{
+		"seconds": TimeEpochSeconds,
+		"millis":  TimeEpochMillis,
+		"micros":  TimeEpochMicros,
+		"nanos":   TimeEpochNanos,
+		"float":   TimeEpochFloatSeconds,
+	}
+
+	// timeValues maps the names of the value tag option to their time options.
+	timeValues = map[string]TimeOptionFn{
+		"native": WithTimeValueNative(),
+		"layout": WithTimeValueLayout(),
+		"unix":   WithTimeValueUnix(),
+	}
+
+	// sqliteTimeFormats maps the names of the sqlite tag option to their SQLite time formats.
+	sqliteTimeFormats = map[string]SQLiteTimeFormat{
+		"text":   SQLiteTimeText,
+		"julian": SQLiteTimeJulianDay,
+		"unix":   SQLiteTimeUnix,
+	}
+
+	// bytesEncodings maps the names of the encoding tag option to their bytes encodings.
+	bytesEncodings = map[string]bytesEncoding{
+		"base64":    bytesEncodingBase64,
+		"base64url": bytesEncodingBase64URL,
+		"hex":       bytesEncodingHex,
+		"raw":       bytesEncodingRaw,
+		"array":     bytesEncodingArray,
+	}
+)
+
+// tagOptionApplier is implemented by the nullable types that can be configured by TagName.
+type tagOptionApplier interface {
+	applyTagOption(key string, value string) error
+}
+
+// tagOptionRanker is implemented by the nullable types whose tag options must be applied in a fixed order,
+// because they override each other. Options with a lower rank are applied first,
+// and options of the same rank in the order of the tag.
+type tagOptionRanker interface {
+	tagOptionRank(key string) int
+}
+
+// Decoder reads and decodes JSON values from an input stream
+// and applies the TagName options of the destination.
+type Decoder struct {
+	*json.Decoder
+
+	// config is bound to the destination if it is not nil.
+	config *Config
+}
+
+// NewDecoder returns a new Decoder that reads from r.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{
+		Decoder: json.NewDecoder(r),
+	}
+}
+
+// WithConfig binds config to the destination of every call to Decode, see Config.Apply.
+func (d *Decoder) WithConfig(config Config) *Decoder {
+	d.config = &config
+
+	return d
+}
+
+// Decode reads the next JSON-encoded value from its input and stores it in the value pointed to by dest.
+// The TagName options, and the Config of WithConfig, are applied before and after decoding, see Unmarshal.
+func (d *Decoder) Decode(dest any) error {
+	if err := d.apply(dest); err != nil {
+		return err
+	}
+
+	if err := d.Decoder.Decode(dest); err != nil {
+		return err
+	}
+
+	return d.apply(dest)
+}
+
+// apply applies the Config of the Decoder, or only the TagName options if it has none, to dest.
+func (d *Decoder) apply(dest any) error {
+	if d.config != nil {
+		return d.config.Apply(dest)
+	}
+
+	return ApplyTags(dest)
+}
+
+// Unmarshal parses the JSON-encoded data and stores the result in the value pointed to by dest.
+// The TagName options are applied before decoding, so that the parse options are honoured,
+// and again after decoding, so that elements of slices and pointers created while decoding are configured.
+// Elements created while decoding are parsed with their default options.
+func Unmarshal(data []byte, dest any) error {
+	if err := ApplyTags(dest); err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(data, dest); err != nil {
+		return err
+	}
+
+	return ApplyTags(dest)
+}
+
+// ApplyTags applies the TagName options to the nullable fields of the value pointed to by dest,
+// including the fields of nested structs and the elements of slices, arrays and pointers.
+// It can be called before scanning or decoding, so that the parse options are honoured, or after,
+// since the options are kept when the value is scanned or decoded.
+// Invalid options are returned as a TagError.
+func ApplyTags(dest any) error {
+	return walkNullables(dest, func(n configBinder, name string, tag string) error {
+		if applier, ok := n.(tagOptionApplier); ok {
+			return applyTagOptions(applier, name, tag)
+		}
+
+		if strings.TrimSpace(tag) != ZeroString {
+			return NewTagError(name, tag, ErrTagOptionUnsupported)
+		}
+
+		return nil
+	})
+}
+
+// configBinder is implemented by all nullable types through NullableImpl.
+type configBinder interface {
+	bindConfig(config *Config)
+}
+
+// walkNullables calls visit for the nullable fields of the value pointed to by dest, including the fields
+// of nested structs and the elements of slices, arrays and pointers, with their name and TagName tag.
+// The tag of a slice, array or pointer field is passed for its elements. The errors of visit are joined.
+func walkNullables(dest any, visit func(n configBinder, name string, tag string) error) error {
+	value := reflect.ValueOf(dest)
+
+	if value.Kind() != reflect.Pointer || value.IsNil() {
+		return ErrInvalidDestination
+	}
+
+	return walkValue(value.Elem(), ZeroString, ZeroString, make(map[walkKey]bool), visit)
+}
+
+// walkKey identifies a pointer or slice on the path of walkValue.
+type walkKey struct {
+	pointer uintptr
+	typ     reflect.Type
+}
+
+// walkValue walks the addressable value, which is named name.
+// visited holds the pointers and slices on the current path, which guards against cycles,
+// such as a struct that points back to its parent.
+func walkValue(
+	value reflect.Value,
+	name string,
+	tag string,
+	visited map[walkKey]bool,
+	visit func(n configBinder, name string, tag string) error,
+) error {
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Slice:
+		if value.IsNil() {
+			return nil
+		}
+
+		key := walkKey{pointer: value.Pointer(), typ: value.Type()}
+
+		if visited[key] {
+			return nil
+		}
+
+		visited[key] = true
+		defer delete(visited, key)
+	}
+
+	switch value.Kind() {
+	case reflect.Pointer:
+		return walkValue(value.Elem(), name, tag, visited, visit)
+	case reflect.Slice, reflect.Array:
+		switch value.Type().Elem().Kind() {
+		case reflect.Struct, reflect.Pointer, reflect.Slice, reflect.Array:
+		default:
+			return nil
+		}
+
+		var errs []error
+
+		for i := range value.Len() {
+			itemName := fmt.Sprintf("%s[%d]", name, i)
+			errs = append(errs, walkValue(value.Index(i), itemName, tag, visited, visit))
+		}
+
+		return errors.Join(errs...)
+	case reflect.Struct:
+		if n, ok := value.Addr().Interface().(configBinder); ok {
+			return visit(n, name, tag)
+		}
+
+		return walkStruct(value, name, visited, visit)
+	default:
+		return nil
+	}
+}
+
+// walkStruct walks the exported fields of the addressable struct value.
+// Fields tagged with "-" are skipped and the fields of embedded structs keep the name of the struct.
+func walkStruct(
+	value reflect.Value,
+	name string,
+	visited map[walkKey]bool,
+	visit func(n configBinder, name string, tag string) error,
+) error {
+	var errs []error
+
+	for i := range value.NumField() {
+		field := value.Type().Field(i)
+		tag := field.Tag.Get(TagName)
+
+		if !field.IsExported() || tag == "-" {
+			continue
+		}
+
+		fieldName := name
+
+		switch {
+		case field.Anonymous && tag == ZeroString:
+		case name == ZeroString:
+			fieldName = field.Name
+		default:
+			fieldName = name + "." + field.Name
+		}
+
+		errs = append(errs, walkValue(value.Field(i), fieldName, tag, visited, visit))
+	}
+
+	return errors.Join(errs...)
+}
+
+// applyTagOptions applies the comma-separated options of tag to applier, in the order of tagOptionRanker.
+func applyTagOptions(applier tagOptionApplier, name string, tag string) error {
+	options := strings.Split(tag, ",")
+
+	if ranker, ok := applier.(tagOptionRanker); ok {
+		slices.SortStableFunc(options, func(a, b string) int {
+			keyA, _, _ := strings.Cut(strings.TrimSpace(a), "=")
+			keyB, _, _ := strings.Cut(strings.TrimSpace(b), "=")
+
+			return cmp.Compare(ranker.tagOptionRank(keyA), ranker.tagOptionRank(keyB))
+		})
+	}
+
+	for _, option := range options {
+		option = strings.TrimSpace(option)
+
+		if option == ZeroString {
+			continue
+		}
+
+		key, value, _ := strings.Cut(option, "=")
+
+		if err := applier.applyTagOption(key, value); err != nil {
+			return NewTagError(name, option, err)
+		}
+	}
+
+	return nil
+}
+
+// applyTagOption implements tagOptionApplier.
+func (n *Time) applyTagOption(key string, value string) error {
+	switch key {
+	case "layout":
+		if layout, ok := timeLayouts[value]; ok {
+			value = layout
+		}
+
+		n.layout = value
+	case "lenient":
+		n.isStrictLayout = false
+	case "strict":
+		n.isStrictLayout = true
+	case "epoch":
+		epoch, ok := timeEpochs[value]
+
+		if !ok {
+			return ErrTagOptionUnsupported
+		}
+
+		WithTimeEpoch(epoch)(n)
+	case "value":
+		option, ok := timeValues[value]
+
+		if !ok {
+			return ErrTagOptionUnsupported
+		}
+
+		option(n)
+	case "location":
+		location, err := time.LoadLocation(value)
+
+		if err != nil {
+			return err
+		}
+
+		n.location = location
+	case "valuelocation":
+		location, err := time.LoadLocation(value)
+
+		if err != nil {
+			return err
+		}
+
+		n.valueLocation = location
+	case "sqlite":
+		format, ok := sqliteTimeFormats[value]
+
+		if !ok {
+			return ErrTagOptionUnsupported
+		}
+
+		WithTimeSQLite(format)(n)
+	case "utc":
+		n.location = time.UTC
+	case "truncate":
+		precision, err := time.ParseDuration(value)
+
+		if err != nil {
+			return err
+		}
+
+		n.truncation = precision
+	default:
+		return ErrTagOptionUnsupported
+	}
+
+	if n.IsValid() {
+		n.value = n.normalize(n.value)
+	}
+
+	return nil
+}
+
+// tagOptionRank implements tagOptionRanker. The sqlite option is applied first,
+// so that the value and epoch options override its value format in any order.
+func (n *Time) tagOptionRank(key string) int {
+	if key == "sqlite" {
+		return 0
+	}
+
+	return 1
+}
+
+// applyTagOption implements tagOptionApplier.
+func (n *String) applyTagOption(key string, value string) error {
+	switch key {
+	case "emptyasnull":
+		n.emptyAsNull = true
+	case "whitespaceasnull":
+		n.whitespaceAsNull = true
+	case "trimspace":
+		n.trimSpace = true
+	case "lower":
+		n.stringCase = stringCaseLower
+	case "upper":
+		n.stringCase = stringCaseUpper
+	case "maxlength":
+		length, err := strconv.Atoi(value)
+
+		if err != nil {
+			return err
+		}
+
+		n.maxLength = length
+	case "truncate":
+		n.truncate = true
+	default:
+		return ErrTagOptionUnsupported
+	}
+
+	n.normalize()
+
+	return nil
+}
+
+// applyTagOption implements tagOptionApplier.
+func (n *Bytes) applyTagOption(key string, value string) error {
+	encoding, ok := bytesEncodings[value]
+
+	if key != "encoding" || !ok {
+		return ErrTagOptionUnsupported
+	}
+
+	n.encoding = encoding
+
+	return nil
+}
+
+// applyTagOption implements tagOptionApplier. JSON does not support any options.
+func (n *JSON) applyTagOption(string, string) error {
+	return ErrTagOptionUnsupported
+}
+
+// applyTagOption implements tagOptionApplier.
+func (n *Int) applyTagOption(key string, value string) error {
+	return applyValuerTagOption(n.setValuerType, key, value)
+}
+
+// applyTagOption implements tagOptionApplier.
+func (n *Int8) applyTagOption(key string, value string) error {
+	return applyValuerTagOption(n.setValuerType, key, value)
+}
+
+// applyTagOption implements tagOptionApplier.
+func (n *Int16) applyTagOption(key string, value string) error {
+	return applyValuerTagOption(n.setValuerType, key, value)
+}
+
+// applyTagOption implements tagOptionApplier.
+func (n *Int32) applyTagOption(key string, value string) error {
+	return applyValuerTagOption(n.setValuerType, key, value)
+}
+
+// applyTagOption implements tagOptionApplier.
+func (n *Int64) applyTagOption(key string, value string) error {
+	return applyValuerTagOption(n.setValuerType, key, value)
+}
+
+// applyTagOption implements tagOptionApplier.
+func (n *Uint) applyTagOption(key string, value string) error {
+	return applyValuerTagOption(n.setValuerType, key, value)
+}
+
+// applyTagOption implements tagOptionApplier.
+func (n *Uint8) applyTagOption(key string, value string) error {
+	return applyValuerTagOption(n.setValuerType, key, value)
+}
+
+// applyTagOption implements tagOptionApplier.
+func (n *Uint16) applyTagOption(key string, value string) error {
+	return applyValuerTagOption(n.setValuerType, key, value)
+}
+
+// applyTagOption implements tagOptionApplier.
+func (n *Uint32) applyTagOption(key string, value string) error {
+	return applyValuerTagOption(n.setValuerType, key, value)
+}
+
+// applyTagOption implements tagOptionApplier.
+func (n *Uint64) applyTagOption(key string, value string) error {
+	return applyValuerTagOption(n.setValuerType, key, value)
+}
+
+// applyValuerTagOption applies the valuer option through setValuerType.
+func applyValuerTagOption(setValuerType func(...IntegerOption), key string, value string) error {
+	option, ok := integerValuers[value]
+
+	if key != "valuer" || !ok {
+		return ErrTagOptionUnsupported
+	}
+
+	setValuerType(option)
+
+	return nil
+}
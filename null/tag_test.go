@@ -0,0 +1,222 @@
+package null
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type taggedDocument struct {
+	Date     Time            `json:"date"     null:"layout=DateOnly,lenient"`
+	Created  Time            `json:"created"  null:"layout=2006-01-02 15:04"`
+	Count    Int8            `json:"count"    null:"valuer=int64"`
+	Name     String          `json:"name"     null:"emptyasnull,trimspace,lower"`
+	Data     Bytes           `json:"data"     null:"encoding=hex"`
+	Optional OptionalString  `json:"optional" null:"upper"`
+	Items    []taggedItem    `json:"items"`
+	Pointer  *Uint16         `json:"pointer"  null:"valuer=uint8"`
+	Skipped  Int             `json:"skipped"  null:"-"`
+	Untagged Time            `json:"untagged"`
+	Dates    []Time          `json:"dates"    null:"layout=DateOnly"`
+	Nested   *taggedDocument `json:"nested"`
+}
+
+type taggedItem struct {
+	Code String `json:"code" null:"upper,maxlength=3,truncate"`
+}
+
+func TestApplyTags(t *testing.T) {
+	var document taggedDocument
+	document.Pointer = new(Uint16)
+	document.Name = StringFrom(" ")
+	document.Items = []taggedItem{{Code: StringFrom("abcd")}}
+
+	require.NoError(t, ApplyTags(&document))
+
+	assert.Equal(t, time.DateOnly, document.Date.layout)
+	assert.False(t, document.Date.isStrictLayout)
+	assert.Equal(t, "2006-01-02 15:04", document.Created.layout)
+	assert.Equal(t, ZeroInt64, document.Count.valuerType)
+	assert.False(t, document.Name.IsValid())
+	assert.Equal(t, bytesEncodingHex, document.Data.encoding)
+	assert.Equal(t, stringCaseUpper, document.Optional.stringCase)
+	assert.Equal(t, "ABCD", document.Items[0].Code.ValueOrZero())
+	assert.Equal(t, ZeroUint8, document.Pointer.valuerType)
+	assert.Nil(t, document.Skipped.valuerType)
+	assert.Equal(t, Time{}, document.Untagged)
+
+	value, err := document.Items[0].Code.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "ABC", value)
+}
+
+func TestApplyTagsErrors(t *testing.T) {
+	require.ErrorIs(t, ApplyTags(nil), ErrInvalidDestination)
+	require.ErrorIs(t, ApplyTags(taggedDocument{}), ErrInvalidDestination)
+	require.ErrorIs(t, ApplyTags((*taggedDocument)(nil)), ErrInvalidDestination)
+
+	testCases := []struct {
+		name  string
+		dest  any
+		field string
+		err   error
+	}{
+		{
+			name: "unknown option",
+			dest: &struct {
+				Value Time `null:"unknown"`
+			}{},
+			field: "Value",
+			err:   ErrTagOptionUnsupported,
+		},
+		{
+			name: "unknown valuer",
+			dest: &struct {
+				Value Int `null:"valuer=int128"`
+			}{},
+			field: "Value",
+			err:   ErrTagOptionUnsupported,
+		},
+		{
+			name: "unknown encoding",
+			dest: &struct {
+				Value Bytes `null:"encoding=base32"`
+			}{},
+			field: "Value",
+			err:   ErrTagOptionUnsupported,
+		},
+		{
+			name: "json",
+			dest: &struct {
+				Value JSON `null:"encoding=hex"`
+			}{},
+			field: "Value",
+			err:   ErrTagOptionUnsupported,
+		},
+		{
+			name: "nested",
+			dest: &struct {
+				Items []taggedItem
+				Inner struct {
+					Value String `null:"maxlength=many"`
+				}
+			}{
+				Items: []taggedItem{{}},
+			},
+			field: "Inner.Value",
+			err:   ErrInvalidTagOption,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := ApplyTags(testCase.dest)
+			require.ErrorIs(t, err, ErrInvalidTagOption)
+			require.ErrorIs(t, err, testCase.err)
+
+			var tagErr TagError
+			require.ErrorAs(t, err, &tagErr)
+			assert.Equal(t, testCase.field, tagErr.Field())
+		})
+	}
+}
+
+type taggedNode struct {
+	Value    Int `null:"valuer=int32"`
+	Parent   *taggedNode
+	Children []taggedNode
+}
+
+func TestApplyTagsCycles(t *testing.T) {
+	root := &taggedNode{}
+	root.Parent = root
+	root.Children = []taggedNode{{Parent: root}}
+	root.Children[0].Children = root.Children
+
+	require.NoError(t, ApplyTags(root))
+
+	root.Value.SetValue(1)
+	value, err := root.Value.Value()
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), value)
+
+	root.Children[0].Value.SetValue(2)
+	value, err = root.Children[0].Value.Value()
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), value)
+}
+
+func TestApplyTagsTimeOrder(t *testing.T) {
+	var document struct {
+		SQLiteFirst Time `null:"sqlite=text,value=layout"`
+		ValueFirst  Time `null:"value=layout,sqlite=text"`
+	}
+
+	require.NoError(t, ApplyTags(&document))
+	assert.Equal(t, document.SQLiteFirst, document.ValueFirst)
+
+	document.ValueFirst.SetValue(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC))
+	value, err := document.ValueFirst.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "2006-01-02T15:04:05Z", value)
+
+	require.NoError(t, document.ValueFirst.Scan(int64(0)))
+	assert.Equal(t, time.Unix(0, 0).UTC(), document.ValueFirst.ValueOrZero())
+}
+
+func TestApplyTagsNormalizesValidTime(t *testing.T) {
+	document := struct {
+		Created Time `null:"utc,truncate=1s"`
+		Missing Time `null:"utc"`
+	}{
+		Created: TimeFrom(time.Date(2006, 1, 2, 15, 4, 5, 123, time.FixedZone("", 3600))),
+	}
+
+	require.NoError(t, ApplyTags(&document))
+	assert.Equal(t, time.Date(2006, 1, 2, 14, 4, 5, 0, time.UTC), document.Created.ValueOrZero())
+	assert.Equal(t, ZeroTime, document.Missing.ValueOrZero())
+}
+
+func TestUnmarshal(t *testing.T) {
+	data := []byte(`{
+		"date": "3 February 2024",
+		"count": 1,
+		"name": "  ",
+		"data": "00ff",
+		"optional": "value",
+		"items": [{"code": "abcd"}],
+		"dates": ["2024-02-03T10:00:00Z"],
+		"nested": {"name": " Nested "}
+	}`)
+
+	var document taggedDocument
+	require.NoError(t, Unmarshal(data, &document))
+
+	assert.Equal(t, "2024-02-03", document.Date.FormatLayout(WithTimeLayoutFormat()))
+	assert.Equal(t, ZeroInt64, document.Count.valuerType)
+	assert.False(t, document.Name.IsValid())
+	assert.Equal(t, []byte{0x00, 0xff}, document.Data.ValueOrZero())
+	assert.Equal(t, "VALUE", document.Optional.ValueOrZero())
+	assert.True(t, document.Optional.IsSet())
+	assert.Equal(t, "ABCD", document.Items[0].Code.ValueOrZero())
+	assert.Equal(t, "2024-02-03", document.Dates[0].FormatLayout(WithTimeLayoutFormat()))
+	assert.Equal(t, "nested", document.Nested.Name.ValueOrZero())
+
+	err := Unmarshal([]byte(`{"count": 1}`), &struct {
+		Count Int `null:"valuer=int128"`
+	}{})
+	require.ErrorIs(t, err, ErrInvalidTagOption)
+}
+
+func TestDecoder(t *testing.T) {
+	decoder := NewDecoder(strings.NewReader(`{"data": "00ff"} {"data": "0g"}`))
+
+	var document taggedDocument
+	require.NoError(t, decoder.Decode(&document))
+	assert.Equal(t, []byte{0x00, 0xff}, document.Data.ValueOrZero())
+
+	require.ErrorIs(t, decoder.Decode(&document), ErrCannotUnmarshal)
+}
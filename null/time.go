@@ -2,21 +2,29 @@ package null // import "github.com/Patrick-Batenburg/nullify/null"
 
 import (
 	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/xml"
+	"fmt"
 	"strconv"
 	"time"
 
 	"github.com/itlightning/dateparse"
+	"gopkg.in/yaml.v3"
 )
 
 // DateParsePreferMonthFirst is an option that allows preferMonthFirst to be changed from its default
+//
+// Deprecated: Use Config.PreferMonthFirst instead, which is safe for concurrent use.
+// It must not be changed concurrently with the use of any value, see DefaultConfig.
 var DateParsePreferMonthFirst = true
 
 // Time is a NullableImpl time.Time. It supports SQL and JSON serialization.
 type Time struct {
 	NullableImpl[time.Time]
 
-	// layout determines how the Time should be formatted when driver.Valuer,
-	// json.Marshaler and encoding.TextMarshaler are called.
+	// layout determines how the Time should be formatted when json.Marshaler and
+	// encoding.TextMarshaler are called, and when driver.Valuer is called with WithTimeValueLayout.
 	layout string
 
 	// isStrictLayout determines if the layout should be strictly or leniently parsed
@@ -26,6 +34,40 @@ type Time struct {
 	// parseOptions is a slice of parse options when sql.Scanner,
 	// json.Unmarshaler and encoding.TextUnMarshaler are called.
 	parseOptions []dateparse.ParserOption
+
+	// jsonEpoch determines the epoch representation when
+	// json.Marshaler and json.Unmarshaler are called.
+	jsonEpoch TimeEpoch
+
+	// textEpoch determines the epoch representation when
+	// encoding.TextMarshaler and encoding.TextUnmarshaler are called.
+	textEpoch TimeEpoch
+
+	// scanEpoch determines the epoch representation of numbers when sql.Scanner is called.
+	scanEpoch TimeEpoch
+
+	// valueEpoch determines the epoch representation when driver.Valuer is called.
+	valueEpoch TimeEpoch
+
+	// valueLayout determines if the Time is formatted according to its layout when driver.Valuer is called.
+	valueLayout bool
+
+	// valueLocation is the location the Time is converted to when driver.Valuer is called, if not nil.
+	valueLocation *time.Location
+
+	// location is the location the Time is converted to when it is constructed, scanned,
+	// unmarshaled, marshaled and valued, if not nil.
+	location *time.Location
+
+	// truncation is the precision the Time is truncated to when it is constructed, scanned,
+	// unmarshaled, marshaled and valued, if greater than zero.
+	truncation time.Duration
+
+	// sqliteScan determines if the storage classes of SQLite are scanned when sql.Scanner is called.
+	sqliteScan bool
+
+	// sqliteValue determines the SQLite storage format when driver.Valuer is called.
+	sqliteValue SQLiteTimeFormat
 }
 
 // NewTime creates a new Time with RFC3339 layout.
@@ -40,6 +82,8 @@ func NewTime(value time.Time, valid bool, options ...TimeOptionFn) Time {
 		option(n)
 	}
 
+	n.value = n.normalize(n.value)
+
 	return *n
 }
 
@@ -55,6 +99,8 @@ func TimeFrom(value time.Time, options ...TimeOptionFn) Time {
 		option(n)
 	}
 
+	n.value = n.normalize(n.value)
+
 	return *n
 }
 
@@ -70,29 +116,117 @@ func TimeFromPtr(value *time.Time, options ...TimeOptionFn) Time {
 		option(n)
 	}
 
+	n.value = n.normalize(n.value)
+
 	return *n
 }
 
 // MarshalJSON implements json.Marshaler.
+// The Time is encoded as a number if an epoch is set by WithTimeJSONEpoch.
 func (n Time) MarshalJSON() ([]byte, error) {
 	if !n.IsValid() {
 		return NullStringBytes, nil
 	}
 
-	return []byte(strconv.Quote(n.Format(WithTimeLayoutFormat()))), nil
+	if n.jsonEpoch != TimeEpochNone {
+		text, err := n.jsonEpoch.format(n.normalize(n.value))
+
+		if err != nil {
+			return nil, NewMarshalError(n, err)
+		}
+
+		return []byte(text), nil
+	}
+
+	return []byte(strconv.Quote(n.FormatLayout(WithTimeLayoutFormat()))), nil
 }
 
 // MarshalText implements encoding.TextMarshaler.
+// The Time is encoded as a number if an epoch is set by WithTimeTextEpoch.
 func (n Time) MarshalText() ([]byte, error) {
 	if !n.IsValid() {
 		return EmptyBytes, nil
 	}
 
-	return []byte(n.Format(WithTimeLayoutFormat())), nil
+	if n.textEpoch != TimeEpochNone {
+		text, err := n.textEpoch.format(n.normalize(n.value))
+
+		if err != nil {
+			return nil, NewMarshalError(n, err)
+		}
+
+		return []byte(text), nil
+	}
+
+	return []byte(n.FormatLayout(WithTimeLayoutFormat())), nil
+}
+
+// Value implements the driver.Valuer interface.
+// By default the Time is a time.Time. It is a string formatted according to its layout if
+// WithTimeValueLayout is used, or an int64, or a float64 for TimeEpochFloatSeconds, if an epoch
+// is set by WithTimeValueEpoch, or according to the format of WithTimeSQLite.
+// The Time is first converted to the location of WithTimeValueLocation.
+func (n Time) Value() (driver.Value, error) {
+	if !n.IsValid() {
+		return nil, nil
+	}
+
+	value := n.normalize(n.value)
+
+	if n.valueLocation != nil {
+		value = value.In(n.valueLocation)
+	}
+
+	switch {
+	case n.valueEpoch != TimeEpochNone:
+		epochValue, err := n.valueEpoch.value(value)
+
+		if err != nil {
+			return nil, NewValuerError(n, err)
+		}
+
+		return epochValue, nil
+	case n.sqliteValue != SQLiteTimeNone:
+		return n.sqliteValue.value(value), nil
+	case n.valueLayout:
+		return value.Format(n.currentConfig().timeLayout(n.layout)), nil
+	default:
+		return value, nil
+	}
 }
 
 // Scan implements the sql.Scanner interface.
+// With WithTimeSQLite the storage classes of SQLite are scanned: text is parsed according to the
+// time values of SQLite, a float64 is a Julian day number and an int64 is Unix seconds.
+// Otherwise numbers, and their text, are converted according to the epoch set by WithTimeScanEpoch.
+// Without it an int64 is Unix seconds and text is parsed as a date.
 func (n *Time) Scan(src any) (err error) {
+	if n.sqliteScan && src != nil {
+		value, err := scanSQLiteTime(src)
+
+		if err != nil {
+			return NewScannerError(src, n, err)
+		}
+
+		n.value = n.normalize(value)
+		n.valid = true
+
+		return nil
+	}
+
+	if n.scanEpoch != TimeEpochNone && src != nil {
+		value, err := n.scanEpoch.scan(src)
+
+		if err != nil {
+			return NewScannerError(src, n, err)
+		}
+
+		n.value = n.normalize(value)
+		n.valid = true
+
+		return nil
+	}
+
 	switch v := src.(type) {
 	case time.Time:
 		n.value = v
@@ -109,7 +243,7 @@ func (n *Time) Scan(src any) (err error) {
 			return NewScannerError(v, n, err)
 		}
 	case int64:
-		n.value = time.Unix(v, 0).UTC()
+		n.value = n.normalize(time.Unix(v, 0).UTC())
 		n.valid = true
 
 		return nil
@@ -122,6 +256,7 @@ func (n *Time) Scan(src any) (err error) {
 		return NewScannerError(v, n)
 	}
 
+	n.value = n.normalize(n.value)
 	n.valid = true
 
 	return nil
@@ -136,6 +271,25 @@ func (n *Time) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
+	if n.jsonEpoch != TimeEpochNone {
+		text := string(data)
+
+		if unquoted, err := strconv.Unquote(text); err == nil {
+			text = unquoted
+		}
+
+		value, err := n.jsonEpoch.parse(text)
+
+		if err != nil {
+			return NewUnmarshalError(data, n, err)
+		}
+
+		n.value = n.normalize(value)
+		n.valid = true
+
+		return nil
+	}
+
 	str, err := strconv.Unquote(string(data))
 
 	if err != nil {
@@ -148,6 +302,7 @@ func (n *Time) UnmarshalJSON(data []byte) error {
 		return NewUnmarshalError(data, n, err)
 	}
 
+	n.value = n.normalize(n.value)
 	n.valid = true
 
 	return nil
@@ -162,21 +317,31 @@ func (n *Time) UnmarshalText(text []byte) (err error) {
 		return nil
 	}
 
-	n.value, err = n.parseTime(string(text))
+	if n.textEpoch != TimeEpochNone {
+		n.value, err = n.textEpoch.parse(string(text))
+	} else {
+		n.value, err = n.parseTime(string(text))
+	}
 
 	if err != nil {
 		return NewUnmarshalError(text, n, err)
 	}
 
+	n.value = n.normalize(n.value)
 	n.valid = true
 
 	return nil
 }
 
-// Format returns a textual representation of the time value formatted according
+// FormatLayout returns a textual representation of the time value formatted according
 // to the layout defined by the argument.
 // If none option argument is specified then it defaults to RFC3339.
-func (n Time) Format(options ...TimeFormatOption) string {
+// An empty layout defaults to the TimeLayout of the Config.
+// The time value is converted to the location and truncated to the precision of the Time first.
+// It was named Format until Time implemented fmt.Formatter, which claims that name.
+func (n Time) FormatLayout(options ...TimeFormatOption) string {
+	value := n.normalize(n.value)
+
 	if len(options) > 0 {
 		option := new(timeFormatOption)
 		options[0](option)
@@ -185,23 +350,264 @@ func (n Time) Format(options ...TimeFormatOption) string {
 			option.layout = n.layout
 		}
 
-		if option.layout == ZeroString {
-			option.layout = time.RFC3339
+		return value.Format(n.currentConfig().timeLayout(option.layout))
+	}
+
+	return value.Format(time.RFC3339)
+}
+
+// Format implements the fmt.Formatter interface. %v, %s and %q print the text of the Time, formatted
+// according to its layout or epoch, while %+v and %#v print the time and its validity.
+// Invalid values print the NullText of the Config. Use FormatLayout to format the time with a layout.
+func (n Time) Format(f fmt.State, verb rune) {
+	if n.IsValid() &&
+		(verb == 's' || verb == 'q' || (verb == 'v' && !f.Flag('#') && !f.Flag('+'))) {
+		if verb == 'v' {
+			verb = 's'
 		}
 
-		return n.value.Format(option.layout)
+		fmt.Fprintf(f, fmt.FormatString(f, verb), textString(n.MarshalText))
+
+		return
+	}
+
+	format(f, verb, n, n.value, n.IsValid(), n.currentConfig().NullText)
+}
+
+// Equal returns true if both values are valid and represent the same instant,
+// regardless of their locations and monotonic clock readings.
+func (n Time) Equal(other NullableImpl[time.Time]) bool {
+	return n.IsValid() && other.IsValid() && n.value.Equal(other.value)
+}
+
+// normalize converts value to the location of WithTimeLocation and truncates it
+// to the precision of WithTimeTruncate.
+func (n Time) normalize(value time.Time) time.Time {
+	if n.location != nil {
+		value = value.In(n.location)
+	}
+
+	if n.truncation > 0 {
+		value = value.Truncate(n.truncation)
 	}
 
-	return n.value.Format(time.RFC3339)
+	return value
 }
 
 // parseTime parses a formatted string and returns the time value it represents.
 // In strict mode if the date is ambigous mm/dd vs dd/mm it will return an error.
 // Otherwise parses an unknown date format and detects the layout.
+// The PreferMonthFirst of the Config applies, unless it is overridden by the parse options.
 func (n Time) parseTime(value string) (format time.Time, err error) {
+	options := append(
+		[]dateparse.ParserOption{dateparse.PreferMonthFirst(n.currentConfig().PreferMonthFirst)},
+		n.parseOptions...,
+	)
+
 	if n.isStrictLayout {
-		return dateparse.ParseStrict(value, n.parseOptions...)
+		return dateparse.ParseStrict(value, options...)
+	}
+
+	return dateparse.ParseAny(value, options...)
+}
+
+// MarshalXML implements xml.Marshaler. It honours the layout of the Time.
+func (n Time) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, n.IsValid(), n.currentConfig().XMLNull, n.MarshalText)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+func (n Time) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, n.IsValid(), n.currentConfig().XMLNull, n.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (n *Time) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, n.UnmarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (n *Time) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, n.UnmarshalText)
+}
+
+// MarshalYAML implements yaml.Marshaler. It honours the layout and the text epoch of the Time.
+func (n Time) MarshalYAML() (any, error) {
+	if !n.IsValid() {
+		return nil, nil
+	}
+
+	if n.textEpoch != TimeEpochNone {
+		text, err := n.MarshalText()
+
+		return string(text), err
+	}
+
+	return n.FormatLayout(WithTimeLayoutFormat()), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (n *Time) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAMLText(node, n, n.UnmarshalText)
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// The layout, strict parsing mode, epochs, value modes, location, truncation
+// and SQLite profile are encoded along with the value.
+// Parse options are functions and cannot be encoded, so they are kept from the receiver when decoding.
+func (n Time) AppendBinary(b []byte) ([]byte, error) {
+	b = appendBinaryFlags(b, n.IsValid())
+	b = appendBinaryBool(b, n.isStrictLayout)
+	b = appendBinaryString(b, n.layout)
+	b = append(b, byte(n.jsonEpoch), byte(n.textEpoch), byte(n.scanEpoch), byte(n.valueEpoch))
+	b = appendBinaryBool(b, n.valueLayout)
+	b = appendBinaryLocation(b, n.valueLocation)
+	b = appendBinaryLocation(b, n.location)
+	b = binary.AppendVarint(b, int64(n.truncation))
+	b = appendBinaryBool(b, n.sqliteScan)
+	b = append(b, byte(n.sqliteValue))
+
+	if !n.IsValid() {
+		return b, nil
+	}
+
+	b, err := appendBinaryValue(b, n.value)
+
+	if err != nil {
+		return b, NewMarshalError(n, err)
+	}
+
+	return b, nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (n Time) GobEncode() ([]byte, error) {
+	return n.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (n *Time) GobDecode(data []byte) error {
+	return n.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (n Time) MarshalBinary() ([]byte, error) {
+	return n.AppendBinary(nil)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (n *Time) UnmarshalBinary(data []byte) error {
+	valid, rest, err := readBinaryFlags(data)
+
+	if err != nil {
+		return NewUnmarshalError(data, n, err)
+	}
+
+	decoded := *n
+	r := binaryReader{data: rest}
+	decoded.isStrictLayout = r.readBool()
+	decoded.layout = r.readString()
+	decoded.jsonEpoch = TimeEpoch(r.readByte(byte(TimeEpochFloatSeconds)))
+	decoded.textEpoch = TimeEpoch(r.readByte(byte(TimeEpochFloatSeconds)))
+	decoded.scanEpoch = TimeEpoch(r.readByte(byte(TimeEpochFloatSeconds)))
+	decoded.valueEpoch = TimeEpoch(r.readByte(byte(TimeEpochFloatSeconds)))
+	decoded.valueLayout = r.readBool()
+	decoded.valueLocation = r.readLocation()
+	decoded.location = r.readLocation()
+	decoded.truncation = time.Duration(r.readVarint())
+	decoded.sqliteScan = r.readBool()
+	decoded.sqliteValue = SQLiteTimeFormat(r.readByte(byte(SQLiteTimeUnix)))
+
+	if r.err != nil {
+		return NewUnmarshalError(data, n, r.err)
+	}
+
+	if err = decoded.unmarshalBinaryValue(valid, r.data); err != nil {
+		return err
+	}
+
+	// The binary format of time.Time keeps the offset but not the location.
+	decoded.value = decoded.normalize(decoded.value)
+	*n = decoded
+
+	return nil
+}
+
+// The kinds of location in the binary wire layout.
+const (
+	// binaryLocationNone encodes a nil location.
+	binaryLocationNone byte = iota
+
+	// binaryLocationNamed encodes a location that is loaded by its name, such as UTC or Europe/Amsterdam.
+	binaryLocationNamed
+
+	// binaryLocationFixed encodes a location that cannot be loaded by its name,
+	// such as a fixed zone, by its name and offset in seconds.
+	binaryLocationFixed
+)
+
+// appendBinaryLocation appends the kind of location to b, followed by its name,
+// and by its offset if it cannot be loaded by its name.
+func appendBinaryLocation(b []byte, location *time.Location) []byte {
+	if location == nil {
+		return append(b, binaryLocationNone)
+	}
+
+	name := location.String()
+	_, offset := ZeroTime.In(location).Zone()
+
+	if loaded, err := time.LoadLocation(name); err == nil && name != ZeroString {
+		if _, loadedOffset := ZeroTime.In(loaded).Zone(); loadedOffset == offset {
+			return appendBinaryString(append(b, binaryLocationNamed), name)
+		}
+	}
+
+	b = appendBinaryString(append(b, binaryLocationFixed), name)
+
+	return binary.AppendVarint(b, int64(offset))
+}
+
+// readLocation reads a location that was appended by appendBinaryLocation.
+func (r *binaryReader) readLocation() *time.Location {
+	switch r.readByte(binaryLocationFixed) {
+	case binaryLocationNamed:
+		name := r.readString()
+
+		if r.err != nil {
+			return nil
+		}
+
+		location, err := time.LoadLocation(name)
+
+		if err != nil {
+			r.err = err
+		}
+
+		return location
+	case binaryLocationFixed:
+		name := r.readString()
+		offset := r.readVarint()
+
+		if r.err != nil {
+			return nil
+		}
+
+		return time.FixedZone(name, int(offset))
+	default:
+		return nil
+	}
+}
+
+// Set implements the flag.Value interface.
+func (n *Time) Set(value string) error {
+	return setFlag(n, value)
+}
+
+// String implements the fmt.Stringer and flag.Value interfaces.
+func (n Time) String() string {
+	if !n.IsValid() {
+		return n.currentConfig().NullText
 	}
 
-	return dateparse.ParseAny(value, n.parseOptions...)
+	return textString(n.MarshalText)
 }
@@ -0,0 +1,239 @@
+package null // import "github.com/Patrick-Batenburg/nullify/null"
+
+import (
+	"database/sql/driver"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimeEpoch determines how a Time is represented as a number relative to the Unix epoch.
+type TimeEpoch int
+
+const (
+	// TimeEpochNone represents a Time by its layout rather than as a number. This is the default.
+	TimeEpochNone TimeEpoch = iota
+
+	// TimeEpochSeconds represents a Time as whole seconds since the Unix epoch.
+	TimeEpochSeconds
+
+	// TimeEpochMillis represents a Time as whole milliseconds since the Unix epoch.
+	TimeEpochMillis
+
+	// TimeEpochMicros represents a Time as whole microseconds since the Unix epoch.
+	TimeEpochMicros
+
+	// TimeEpochNanos represents a Time as nanoseconds since the Unix epoch,
+	// which covers the years 1678 to 2262.
+	TimeEpochNanos
+
+	// TimeEpochFloatSeconds represents a Time as fractional seconds since the Unix epoch,
+	// such as 1.5.
+	// As text the fraction is exact to the nanosecond, while as driver.Value it is a float64.
+	TimeEpochFloatSeconds
+)
+
+// nanosPerSecond is the number of nanoseconds in a second.
+const nanosPerSecond = int64(time.Second)
+
+// unitsPerSecond returns the number of units of an integer epoch in a second.
+func (e TimeEpoch) unitsPerSecond() int64 {
+	switch e {
+	case TimeEpochMillis:
+		return int64(time.Second / time.Millisecond)
+	case TimeEpochMicros:
+		return int64(time.Second / time.Microsecond)
+	case TimeEpochNanos:
+		return nanosPerSecond
+	default:
+		return 1
+	}
+}
+
+// toInt returns value as the number of units since the Unix epoch, truncated to the unit.
+func (e TimeEpoch) toInt(value time.Time) (int64, error) {
+	perSecond := e.unitsPerSecond()
+	seconds := value.Unix()
+	units := int64(value.Nanosecond()) / (nanosPerSecond / perSecond)
+
+	if seconds > (math.MaxInt64-units)/perSecond || seconds < math.MinInt64/perSecond {
+		return 0, ErrTimeEpochOverflow
+	}
+
+	return seconds*perSecond + units, nil
+}
+
+// fromInt returns the time of the number of units since the Unix epoch in UTC.
+func (e TimeEpoch) fromInt(value int64) time.Time {
+	perSecond := e.unitsPerSecond()
+	seconds := value / perSecond
+	units := value % perSecond
+
+	if units < 0 {
+		seconds--
+		units += perSecond
+	}
+
+	return time.Unix(seconds, units*(nanosPerSecond/perSecond)).UTC()
+}
+
+// fromFloatSeconds returns the time of the fractional number of seconds since the Unix epoch in UTC.
+func fromFloatSeconds(value float64) (time.Time, error) {
+	if math.IsNaN(value) || value < math.MinInt64 || value >= math.MaxInt64 {
+		return ZeroTime, ErrTimeEpochOverflow
+	}
+
+	seconds := math.Floor(value)
+	nanos := math.Round((value - seconds) * float64(nanosPerSecond))
+
+	return time.Unix(int64(seconds), int64(nanos)).UTC(), nil
+}
+
+// format returns value as the decimal number of units since the Unix epoch.
+func (e TimeEpoch) format(value time.Time) (string, error) {
+	if e != TimeEpochFloatSeconds {
+		units, err := e.toInt(value)
+
+		if err != nil {
+			return ZeroString, err
+		}
+
+		return strconv.FormatInt(units, 10), nil
+	}
+
+	seconds := value.Unix()
+	nanos := int64(value.Nanosecond())
+	negative := seconds < 0 && nanos > 0
+
+	if negative {
+		seconds++
+		nanos = nanosPerSecond - nanos
+	}
+
+	text := strconv.FormatInt(seconds, 10)
+
+	if negative && seconds == 0 {
+		text = "-" + text
+	}
+
+	if nanos == 0 {
+		return text, nil
+	}
+
+	fraction := strconv.FormatInt(nanosPerSecond+nanos, 10)[1:]
+
+	return text + "." + strings.TrimRight(fraction, "0"), nil
+}
+
+// parse parses the decimal number of units since the Unix epoch and returns the time in UTC.
+func (e TimeEpoch) parse(text string) (time.Time, error) {
+	if e == TimeEpochFloatSeconds {
+		return parseFloatSeconds(text)
+	}
+
+	units, err := strconv.ParseInt(text, 10, 64)
+
+	if errors.Is(err, strconv.ErrRange) {
+		return ZeroTime, ErrTimeEpochOverflow
+	}
+
+	if err != nil {
+		return ZeroTime, err
+	}
+
+	return e.fromInt(units), nil
+}
+
+// parseFloatSeconds parses the fractional number of seconds since the Unix epoch.
+// Decimal fractions are parsed exactly to the nanosecond, while exponents are parsed as a float64.
+func parseFloatSeconds(text string) (time.Time, error) {
+	if strings.ContainsAny(text, "eE") {
+		value, err := strconv.ParseFloat(text, 64)
+
+		if err != nil {
+			return ZeroTime, err
+		}
+
+		return fromFloatSeconds(value)
+	}
+
+	whole, fraction, _ := strings.Cut(text, ".")
+	negative := strings.HasPrefix(whole, "-")
+	seconds, err := strconv.ParseInt(whole, 10, 64)
+
+	if errors.Is(err, strconv.ErrRange) {
+		return ZeroTime, ErrTimeEpochOverflow
+	}
+
+	if err != nil {
+		return ZeroTime, err
+	}
+
+	if strings.Trim(fraction, "0123456789") != ZeroString {
+		return ZeroTime, strconv.ErrSyntax
+	}
+
+	var nanos int64
+
+	if fraction != ZeroString {
+		fraction = (fraction + "00000000")[:9]
+		nanos, _ = strconv.ParseInt(fraction, 10, 64)
+	}
+
+	if negative && nanos > 0 {
+		if seconds == math.MinInt64 {
+			return ZeroTime, ErrTimeEpochOverflow
+		}
+
+		seconds--
+		nanos = nanosPerSecond - nanos
+	}
+
+	return time.Unix(seconds, nanos).UTC(), nil
+}
+
+// value returns value as a driver.Value, which is an int64, or a float64 for TimeEpochFloatSeconds.
+func (e TimeEpoch) value(value time.Time) (driver.Value, error) {
+	if e == TimeEpochFloatSeconds {
+		return float64(value.Unix()) + float64(value.Nanosecond())/float64(nanosPerSecond), nil
+	}
+
+	return e.toInt(value)
+}
+
+// scan converts src, which is a number or its text, to a time.
+// A time.Time is returned as is.
+func (e TimeEpoch) scan(src any) (time.Time, error) {
+	switch v := src.(type) {
+	case time.Time:
+		return v, nil
+	case int64:
+		if e == TimeEpochFloatSeconds {
+			return time.Unix(v, 0).UTC(), nil
+		}
+
+		return e.fromInt(v), nil
+	case float64:
+		if e == TimeEpochFloatSeconds {
+			return fromFloatSeconds(v)
+		}
+
+		if !(v >= math.MinInt64 && v < math.MaxInt64) {
+			return ZeroTime, ErrTimeEpochOverflow
+		}
+
+		if v != math.Trunc(v) {
+			return ZeroTime, ErrTimeEpochFraction
+		}
+
+		return e.fromInt(int64(v)), nil
+	case string:
+		return e.parse(v)
+	case []byte:
+		return e.parse(string(v))
+	default:
+		return ZeroTime, ErrCannotScan
+	}
+}
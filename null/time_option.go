@@ -82,3 +82,123 @@ func WithTimeDefaultLayoutFormat() TimeFormatOption {
 		option.layout = time.RFC3339
 	}
 }
+
+// WithTimeEpoch represents the Time as a number relative to the Unix epoch when
+// json.Marshaler, json.Unmarshaler, encoding.TextMarshaler, encoding.TextUnmarshaler,
+// sql.Scanner and driver.Valuer are called.
+func WithTimeEpoch(epoch TimeEpoch) TimeOptionFn {
+	return func(option *Time) {
+		option.jsonEpoch = epoch
+		option.textEpoch = epoch
+		option.scanEpoch = epoch
+		option.valueEpoch = epoch
+	}
+}
+
+// WithTimeJSONEpoch represents the Time as a number relative to the Unix epoch
+// when json.Marshaler and json.Unmarshaler are called.
+func WithTimeJSONEpoch(epoch TimeEpoch) TimeOptionFn {
+	return func(option *Time) {
+		option.jsonEpoch = epoch
+	}
+}
+
+// WithTimeTextEpoch represents the Time as a number relative to the Unix epoch
+// when encoding.TextMarshaler and encoding.TextUnmarshaler are called.
+func WithTimeTextEpoch(epoch TimeEpoch) TimeOptionFn {
+	return func(option *Time) {
+		option.textEpoch = epoch
+	}
+}
+
+// WithTimeScanEpoch converts numbers, and their text, relative to the Unix epoch when sql.Scanner is called.
+func WithTimeScanEpoch(epoch TimeEpoch) TimeOptionFn {
+	return func(option *Time) {
+		option.scanEpoch = epoch
+	}
+}
+
+// WithTimeValueEpoch represents the Time as a number relative to the Unix epoch when driver.Valuer is called.
+func WithTimeValueEpoch(epoch TimeEpoch) TimeOptionFn {
+	return func(option *Time) {
+		option.valueEpoch = epoch
+		option.valueLayout = false
+		option.sqliteValue = SQLiteTimeNone
+	}
+}
+
+// WithTimeValueNative returns the Time as a time.Time when driver.Valuer is called. This is the default.
+func WithTimeValueNative() TimeOptionFn {
+	return func(option *Time) {
+		option.valueEpoch = TimeEpochNone
+		option.valueLayout = false
+		option.sqliteValue = SQLiteTimeNone
+	}
+}
+
+// WithTimeValueLayout returns the Time as a string formatted according to its layout
+// when driver.Valuer is called, such as for a TEXT column in SQLite.
+func WithTimeValueLayout() TimeOptionFn {
+	return func(option *Time) {
+		option.valueEpoch = TimeEpochNone
+		option.valueLayout = true
+		option.sqliteValue = SQLiteTimeNone
+	}
+}
+
+// WithTimeValueUnix returns the Time as Unix seconds when driver.Valuer is called.
+// It is equivalent to WithTimeValueEpoch(TimeEpochSeconds).
+func WithTimeValueUnix() TimeOptionFn {
+	return WithTimeValueEpoch(TimeEpochSeconds)
+}
+
+// WithTimeValueLocation converts the Time to location when driver.Valuer is called, such as time.UTC.
+func WithTimeValueLocation(location *time.Location) TimeOptionFn {
+	return func(option *Time) {
+		option.valueLocation = location
+	}
+}
+
+// WithTimeLocation converts the Time to location when it is constructed, sql.Scanner,
+// json.Unmarshaler and encoding.TextUnmarshaler are called, and when json.Marshaler,
+// encoding.TextMarshaler and driver.Valuer are called.
+func WithTimeLocation(location *time.Location) TimeOptionFn {
+	return func(option *Time) {
+		option.location = location
+	}
+}
+
+// WithTimeUTC converts the Time to UTC. It is equivalent to WithTimeLocation(time.UTC).
+func WithTimeUTC() TimeOptionFn {
+	return WithTimeLocation(time.UTC)
+}
+
+// WithTimeTruncate truncates the Time to a multiple of precision, such as time.Microsecond for
+// Postgres, when it is constructed, sql.Scanner, json.Unmarshaler and encoding.TextUnmarshaler
+// are called, and when json.Marshaler, encoding.TextMarshaler and driver.Valuer are called.
+func WithTimeTruncate(precision time.Duration) TimeOptionFn {
+	return func(option *Time) {
+		option.truncation = precision
+	}
+}
+
+// WithTimeRFC3339Nano sets the time layout to the RFC3339 format with nanoseconds,
+// so that fractional seconds are not lost.
+func WithTimeRFC3339Nano() TimeOptionFn {
+	return func(option *Time) {
+		option.layout = time.RFC3339Nano
+	}
+}
+
+// WithTimeSQLite scans the storage classes of SQLite when sql.Scanner is called, and stores the Time
+// according to format when driver.Valuer is called, such as SQLiteTimeText for a TEXT column.
+// It replaces the value mode of WithTimeValueNative, WithTimeValueLayout and WithTimeValueEpoch.
+// SQLiteTimeNone disables the profile.
+func WithTimeSQLite(format SQLiteTimeFormat) TimeOptionFn {
+	return func(option *Time) {
+		option.sqliteScan = format != SQLiteTimeNone
+		option.sqliteValue = format
+		option.valueEpoch = TimeEpochNone
+		option.valueLayout = false
+	}
+}
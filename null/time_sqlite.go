@@ -0,0 +1,109 @@
+package null // import "github.com/Patrick-Batenburg/nullify/null"
+
+import (
+	"database/sql/driver"
+	"math"
+	"time"
+)
+
+// SQLiteTimeFormat determines how a Time is stored in SQLite, which has no time type and
+// stores times as TEXT, as REAL Julian day numbers or as INTEGER Unix time.
+type SQLiteTimeFormat int
+
+const (
+	// SQLiteTimeNone disables the SQLite time profile. This is the default.
+	SQLiteTimeNone SQLiteTimeFormat = iota
+
+	// SQLiteTimeText stores a Time as "YYYY-MM-DD HH:MM:SS.SSS" text in UTC,
+	// which sorts chronologically and is understood by the date and time functions of SQLite.
+	SQLiteTimeText
+
+	// SQLiteTimeJulianDay stores a Time as a REAL of the fractional days since noon in Greenwich
+	// on November 24, 4714 B.C., with the millisecond precision of the julianday function of SQLite.
+	SQLiteTimeJulianDay
+
+	// SQLiteTimeUnix stores a Time as an INTEGER of whole seconds since the Unix epoch.
+	SQLiteTimeUnix
+)
+
+const (
+	// sqliteTextLayout is the layout of the text stored by SQLiteTimeText.
+	sqliteTextLayout = "2006-01-02 15:04:05.000"
+
+	// millisPerDay is the number of milliseconds in a day.
+	millisPerDay = int64(24 * time.Hour / time.Millisecond)
+
+	// julianDayUnixEpochMillis is the Julian day of the Unix epoch, 2440587.5, in milliseconds.
+	julianDayUnixEpochMillis = int64(210866760000000)
+)
+
+// sqliteTextLayouts are the text layouts of the time values of SQLite, including the layout
+// go-sqlite3 stores a time.Time in. Fractional seconds are accepted after the seconds.
+var sqliteTextLayouts = []string{
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02T15:04:05Z07:00",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04Z07:00",
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02 15:04",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// value returns value as a driver.Value, which is a string for SQLiteTimeText,
+// a float64 for SQLiteTimeJulianDay and an int64 for SQLiteTimeUnix.
+func (f SQLiteTimeFormat) value(value time.Time) driver.Value {
+	switch f {
+	case SQLiteTimeJulianDay:
+		return float64(value.UnixMilli()+julianDayUnixEpochMillis) / float64(millisPerDay)
+	case SQLiteTimeUnix:
+		return value.Unix()
+	default:
+		return value.UTC().Format(sqliteTextLayout)
+	}
+}
+
+// scanSQLiteTime converts src, which is a value of any of the storage classes of SQLite, to a time.
+// Text is parsed according to the time values of SQLite, a float64 is a Julian day number and an
+// int64 is Unix seconds. A time.Time, such as of a DATETIME column in go-sqlite3, is returned as is.
+// Times without a time zone are in UTC.
+func scanSQLiteTime(src any) (time.Time, error) {
+	switch v := src.(type) {
+	case time.Time:
+		return v, nil
+	case int64:
+		return time.Unix(v, 0).UTC(), nil
+	case float64:
+		return fromJulianDay(v)
+	case string:
+		return parseSQLiteTime(v)
+	case []byte:
+		return parseSQLiteTime(string(v))
+	default:
+		return ZeroTime, ErrCannotScan
+	}
+}
+
+// fromJulianDay returns the time of a Julian day number in UTC,
+// rounded to the millisecond in the same way as SQLite.
+func fromJulianDay(value float64) (time.Time, error) {
+	millis := value*float64(millisPerDay) + 0.5
+
+	if math.IsNaN(millis) || millis < 0 || millis >= math.MaxInt64 {
+		return ZeroTime, ErrInvalidSQLiteTime
+	}
+
+	return time.UnixMilli(int64(millis) - julianDayUnixEpochMillis).UTC(), nil
+}
+
+// parseSQLiteTime parses text according to the layouts of the time values of SQLite.
+func parseSQLiteTime(text string) (time.Time, error) {
+	for _, layout := range sqliteTextLayouts {
+		if value, err := time.Parse(layout, text); err == nil {
+			return value, nil
+		}
+	}
+
+	return ZeroTime, ErrInvalidSQLiteTime
+}
@@ -0,0 +1,134 @@
+//go:build cgo
+
+package null
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func openSQLite(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+
+	// Every connection opens its own in-memory database.
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+
+	_, err = db.Exec(`CREATE TABLE times (t TEXT, j REAL, u INTEGER, d DATETIME)`)
+	require.NoError(t, err)
+
+	return db
+}
+
+func TestTimeSQLiteRoundTrip(t *testing.T) {
+	db := openSQLite(t)
+	value := time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.FixedZone("", 3600))
+
+	_, err := db.Exec(
+		`INSERT INTO times (t, j, u) VALUES (?, ?, ?)`,
+		TimeFrom(value, WithTimeSQLite(SQLiteTimeText)),
+		TimeFrom(value, WithTimeSQLite(SQLiteTimeJulianDay)),
+		TimeFrom(value, WithTimeSQLite(SQLiteTimeUnix)),
+	)
+	require.NoError(t, err)
+
+	var types, text string
+	row := db.QueryRow(`SELECT typeof(t) || typeof(j) || typeof(u), t FROM times`)
+	require.NoError(t, row.Scan(&types, &text))
+	assert.Equal(t, "textrealinteger", types)
+	assert.Equal(t, "2006-01-02 14:04:05.123", text)
+
+	var fromText, fromJulian, fromUnix string
+	row = db.QueryRow(`SELECT datetime(t), datetime(j), datetime(u, 'unixepoch') FROM times`)
+	require.NoError(t, row.Scan(&fromText, &fromJulian, &fromUnix))
+	assert.Equal(t, "2006-01-02 14:04:05", fromText)
+	assert.Equal(t, fromText, fromJulian)
+	assert.Equal(t, fromText, fromUnix)
+
+	times := [3]Time{}
+
+	for i := range times {
+		times[i] = NewTime(ZeroTime, false, WithTimeSQLite(SQLiteTimeText))
+	}
+
+	row = db.QueryRow(`SELECT t, j, u FROM times`)
+	require.NoError(t, row.Scan(&times[0], &times[1], &times[2]))
+	assert.True(t, times[0].Equal(From(value.Truncate(time.Millisecond))))
+	assert.True(t, times[1].Equal(From(value.Truncate(time.Millisecond))))
+	assert.True(t, times[2].Equal(From(value.Truncate(time.Second))))
+	assert.Equal(t, time.UTC, times[0].ValueOrZero().Location())
+}
+
+func TestTimeSQLiteScanNative(t *testing.T) {
+	db := openSQLite(t)
+	expected := time.Date(2006, 1, 2, 14, 4, 5, 123000000, time.UTC)
+
+	// go-sqlite3 stores a time.Time as text with a time zone, and returns a DATETIME column as a time.Time.
+	_, err := db.Exec(
+		`INSERT INTO times (t, d) VALUES (?, ?)`, expected.In(time.FixedZone("", 3600)), expected,
+	)
+	require.NoError(t, err)
+
+	row := db.QueryRow(`
+SELECT
+    t,
+    d,
+    '2006-01-02 14:04:05.123',
+    '2006-01-02T14:04:05.123Z',
+    julianday('2006-01-02 14:04:05.123'),
+    CAST(strftime('%s', '2006-01-02 14:04:05') AS INTEGER),
+    NULL
+FROM times`)
+
+	values := [7]Time{}
+	destinations := make([]any, len(values))
+
+	for i := range values {
+		values[i] = NewTime(ZeroTime, false, WithTimeSQLite(SQLiteTimeText))
+		destinations[i] = &values[i]
+	}
+
+	require.NoError(t, row.Scan(destinations...))
+
+	for i, value := range values[:5] {
+		assert.True(t, value.Equal(From(expected)), "column %d: %v", i, value.ValueOrZero())
+	}
+
+	assert.True(t, values[5].Equal(From(expected.Truncate(time.Second))))
+	assert.False(t, values[6].IsValid())
+}
+
+func TestTimeSQLiteTags(t *testing.T) {
+	db := openSQLite(t)
+
+	var document struct {
+		Created Time `null:"sqlite=julian"`
+		Updated Time `null:"layout=RFC3339,sqlite=text,value=layout"`
+	}
+
+	require.NoError(t, ApplyTags(&document))
+	document.Created.SetValue(time.Date(2006, 1, 2, 14, 4, 5, 0, time.UTC))
+	document.Updated.SetValue(time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", 3600)))
+
+	_, err := db.Exec(`INSERT INTO times (j, t) VALUES (?, ?)`, document.Created, document.Updated)
+	require.NoError(t, err)
+
+	var julianDay float64
+	var text string
+	require.NoError(t, db.QueryRow(`SELECT j, t FROM times`).Scan(&julianDay, &text))
+	assert.InDelta(t, 2453738.0861689816, julianDay, 1e-9)
+	assert.Equal(t, "2006-01-02T15:04:05+01:00", text)
+
+	row := db.QueryRow(`SELECT j, t FROM times`)
+	require.NoError(t, row.Scan(&document.Created, &document.Updated))
+	assert.Equal(t, "2006-01-02T14:04:05Z", document.Created.FormatLayout())
+	assert.Equal(t, "2006-01-02T15:04:05+01:00", document.Updated.FormatLayout())
+}
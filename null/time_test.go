@@ -1,6 +1,7 @@
 package null
 
 import (
+	"database/sql/driver"
 	"encoding/json"
 	"math"
 	"strconv"
@@ -11,6 +12,7 @@ import (
 	"github.com/itlightning/dateparse"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestNewTime(t *testing.T) {
@@ -485,16 +487,24 @@ func TestTimeIsZero(t *testing.T) {
 	)
 
 	zero := NewTime(ZeroTime, true)
-	assert.True(
+	assert.False(
 		t,
 		zero.IsZero(),
 	)
+	assert.True(
+		t,
+		zero.IsZeroValue(),
+	)
 
 	null := NewTime(ZeroTime, false)
 	assert.True(
 		t,
 		null.IsZero(),
 	)
+	assert.True(
+		t,
+		null.IsNull(),
+	)
 }
 
 func TestTimeSetValue(t *testing.T) {
@@ -710,3 +720,476 @@ func TestTimeScan(t *testing.T) {
 		invalid,
 	)
 }
+
+func TestTimeMarshalXML(t *testing.T) {
+	testData := newTimeData()
+	assertXMLRoundTrip(t, TimeFrom(testData.Value), testData.String, NewTime(ZeroTime, false))
+
+	layout := "2006-01-02"
+	date := time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)
+	assertXMLRoundTrip(
+		t,
+		TimeFrom(date, WithTimeLayout(layout)),
+		"2024-02-29",
+		NewTime(ZeroTime, false, WithTimeLayout(layout)),
+	)
+	assertXMLNull(t, NewTime(ZeroTime, false), NewTime(ZeroTime, false))
+}
+
+func TestTimeMarshalYAML(t *testing.T) {
+	testData := newTimeData()
+	assertYAMLRoundTrip(t, TimeFrom(testData.Value), testData.JSON, NewTime(ZeroTime, false))
+
+	layout := "2006-01-02"
+	date := time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)
+	assertYAMLRoundTrip(
+		t,
+		TimeFrom(date, WithTimeLayout(layout)),
+		`"2024-02-29"`,
+		NewTime(ZeroTime, false, WithTimeLayout(layout)),
+	)
+	assertYAMLNull(t, NewTime(ZeroTime, false), TimeFrom(testData.Value))
+
+	var badType Time
+	err := yaml.Unmarshal([]byte("value: [1]"), &yamlDocument[Time]{Value: badType})
+	require.ErrorIs(t, err, ErrCannotUnmarshal)
+}
+
+func TestTimeMarshalBinary(t *testing.T) {
+	testData := newTimeData()
+	assertBinaryRoundTrip(t, TimeFrom(testData.Value))
+	assertBinaryRoundTrip(
+		t,
+		TimeFrom(testData.Value, WithTimeLayout(time.DateOnly), WithTimeLenientParsing()),
+	)
+	assertBinaryRoundTrip(t, NewTime(ZeroTime, false, WithTimeLayout(time.Kitchen)))
+	assertBinaryRoundTrip(t, Time{})
+
+	epochs := TimeFrom(
+		testData.Value,
+		WithTimeJSONEpoch(TimeEpochMillis),
+		WithTimeTextEpoch(TimeEpochFloatSeconds),
+		WithTimeScanEpoch(TimeEpochSeconds),
+		WithTimeValueEpoch(TimeEpochNanos),
+	)
+	assertBinaryRoundTrip(t, epochs)
+	assertGobKeepsJSON(t, epochs)
+
+	assertBinaryRoundTrip(
+		t,
+		TimeFrom(testData.Value, WithTimeValueLayout(), WithTimeValueLocation(time.UTC)),
+	)
+	assertBinaryRoundTrip(
+		t,
+		NewTime(ZeroTime, false, WithTimeValueLocation(time.FixedZone("", -3600))),
+	)
+	assertBinaryRoundTrip(
+		t,
+		NewTime(ZeroTime, false, WithTimeValueLocation(time.FixedZone("UTC", 7200))),
+	)
+
+	amsterdam, err := time.LoadLocation("Europe/Amsterdam")
+	require.NoError(t, err)
+	valueLocation := TimeFrom(testData.Value, WithTimeValueLocation(amsterdam))
+	data, err := valueLocation.MarshalBinary()
+	require.NoError(t, err)
+
+	var decoded Time
+	require.NoError(t, decoded.UnmarshalBinary(data))
+	expected, err := valueLocation.Value()
+	require.NoError(t, err)
+	actual, err := decoded.Value()
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+
+	normalized := TimeFrom(
+		testData.Value,
+		WithTimeLocation(amsterdam),
+		WithTimeTruncate(time.Millisecond),
+		WithTimeRFC3339Nano(),
+	)
+	assertGobKeepsJSON(t, normalized)
+	data, err = normalized.MarshalBinary()
+	require.NoError(t, err)
+	require.NoError(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, amsterdam.String(), decoded.ValueOrZero().Location().String())
+	require.NoError(t, decoded.UnmarshalText([]byte("2006-01-02T15:04:05.123456Z")))
+	text, err := decoded.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "2006-01-02T16:04:05.123+01:00", string(text))
+
+	sqlite := TimeFrom(testData.Value, WithTimeSQLite(SQLiteTimeJulianDay))
+	assertBinaryRoundTrip(t, sqlite)
+	data, err = sqlite.MarshalBinary()
+	require.NoError(t, err)
+	require.NoError(t, decoded.UnmarshalBinary(data))
+	expected, err = sqlite.Value()
+	require.NoError(t, err)
+	actual, err = decoded.Value()
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+	require.NoError(t, decoded.Scan(int64(0)))
+	assert.Equal(t, time.Unix(0, 0).UTC(), decoded.ValueOrZero().UTC())
+
+	err = decoded.UnmarshalBinary([]byte{binaryValidFlag, 1, 0, byte(TimeEpochFloatSeconds) + 1})
+	require.ErrorIs(t, err, ErrInvalidBinaryData)
+	err = decoded.UnmarshalBinary([]byte{binaryValidFlag, 1, 5, 'a'})
+	require.ErrorIs(t, err, ErrInvalidBinaryData)
+}
+
+func TestTimeEpoch(t *testing.T) {
+	value := time.Date(2024, 2, 3, 4, 5, 6, 123456789, time.UTC)
+	before := time.Date(1969, 12, 31, 23, 59, 59, 500000000, time.UTC)
+
+	testCases := []struct {
+		name     string
+		epoch    TimeEpoch
+		value    time.Time
+		text     string
+		expected time.Time
+		driver   driver.Value
+	}{
+		{
+			name:     "seconds",
+			epoch:    TimeEpochSeconds,
+			value:    value,
+			text:     "1706933106",
+			expected: value.Truncate(time.Second),
+			driver:   int64(1706933106),
+		},
+		{
+			name:     "millis",
+			epoch:    TimeEpochMillis,
+			value:    value,
+			text:     "1706933106123",
+			expected: value.Truncate(time.Millisecond),
+			driver:   int64(1706933106123),
+		},
+		{
+			name:     "micros",
+			epoch:    TimeEpochMicros,
+			value:    value,
+			text:     "1706933106123456",
+			expected: value.Truncate(time.Microsecond),
+			driver:   int64(1706933106123456),
+		},
+		{
+			name:     "nanos",
+			epoch:    TimeEpochNanos,
+			value:    value,
+			text:     "1706933106123456789",
+			expected: value,
+			driver:   int64(1706933106123456789),
+		},
+		{
+			name:     "float seconds",
+			epoch:    TimeEpochFloatSeconds,
+			value:    value,
+			text:     "1706933106.123456789",
+			expected: value,
+			driver:   1706933106.123456789,
+		},
+		{
+			name:     "millis before epoch",
+			epoch:    TimeEpochMillis,
+			value:    before,
+			text:     "-500",
+			expected: before,
+			driver:   int64(-500),
+		},
+		{
+			name:     "float seconds before epoch",
+			epoch:    TimeEpochFloatSeconds,
+			value:    before,
+			text:     "-0.5",
+			expected: before,
+			driver:   -0.5,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			n := TimeFrom(testCase.value, WithTimeEpoch(testCase.epoch))
+
+			data, err := n.MarshalJSON()
+			require.NoError(t, err)
+			assert.Equal(t, testCase.text, string(data))
+
+			text, err := n.MarshalText()
+			require.NoError(t, err)
+			assert.Equal(t, testCase.text, string(text))
+
+			value, err := n.Value()
+			require.NoError(t, err)
+			assert.Equal(t, testCase.driver, value)
+
+			unmarshaledJSON := NewTime(ZeroTime, false, WithTimeEpoch(testCase.epoch))
+			require.NoError(t, unmarshaledJSON.UnmarshalJSON(data))
+			assert.True(t, unmarshaledJSON.ValueOrZero().Equal(testCase.expected))
+
+			unmarshaledQuoted := NewTime(ZeroTime, false, WithTimeEpoch(testCase.epoch))
+			quoted := []byte(strconv.Quote(testCase.text))
+			require.NoError(t, unmarshaledQuoted.UnmarshalJSON(quoted))
+			assert.True(t, unmarshaledQuoted.ValueOrZero().Equal(testCase.expected))
+
+			unmarshaledText := NewTime(ZeroTime, false, WithTimeEpoch(testCase.epoch))
+			require.NoError(t, unmarshaledText.UnmarshalText(text))
+			assert.True(t, unmarshaledText.ValueOrZero().Equal(testCase.expected))
+
+			scanned := NewTime(ZeroTime, false, WithTimeEpoch(testCase.epoch))
+			require.NoError(t, scanned.Scan(value))
+			assert.True(t, scanned.ValueOrZero().Round(time.Microsecond).Equal(
+				testCase.expected.Round(time.Microsecond),
+			))
+
+			scannedText := NewTime(ZeroTime, false, WithTimeEpoch(testCase.epoch))
+			require.NoError(t, scannedText.Scan([]byte(testCase.text)))
+			assert.True(t, scannedText.ValueOrZero().Equal(testCase.expected))
+		})
+	}
+}
+
+func TestTimeEpochIndependent(t *testing.T) {
+	value := time.Date(2024, 2, 3, 4, 5, 6, 0, time.UTC)
+	n := TimeFrom(
+		value,
+		WithTimeJSONEpoch(TimeEpochMillis),
+		WithTimeValueEpoch(TimeEpochNanos),
+	)
+
+	data, err := n.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, "1706933106000", string(data))
+
+	text, err := n.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "2024-02-03T04:05:06Z", string(text))
+
+	driverValue, err := n.Value()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1706933106000000000), driverValue)
+
+	require.NoError(t, n.Scan(int64(1706933106)))
+	assert.True(t, n.ValueOrZero().Equal(value))
+
+	var null Time
+	require.NoError(t, null.UnmarshalJSON(NullStringBytes))
+	assert.False(t, null.IsValid())
+}
+
+func TestTimeEpochErrors(t *testing.T) {
+	far := time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := TimeFrom(far, WithTimeEpoch(TimeEpochNanos)).MarshalJSON()
+	require.ErrorIs(t, err, ErrCannotMarshal)
+	require.ErrorIs(t, err, ErrTimeEpochOverflow)
+
+	_, err = TimeFrom(far, WithTimeEpoch(TimeEpochNanos)).Value()
+	require.ErrorIs(t, err, ErrCannotValue)
+	require.ErrorIs(t, err, ErrTimeEpochOverflow)
+
+	n := NewTime(ZeroTime, false, WithTimeEpoch(TimeEpochMillis))
+	err = n.UnmarshalJSON([]byte("99999999999999999999"))
+	require.ErrorIs(t, err, ErrCannotUnmarshal)
+	require.ErrorIs(t, err, ErrTimeEpochOverflow)
+
+	err = n.UnmarshalText([]byte("1.5"))
+	require.ErrorIs(t, err, strconv.ErrSyntax)
+
+	err = n.Scan(1.5)
+	require.ErrorIs(t, err, ErrCannotScan)
+	require.ErrorIs(t, err, ErrTimeEpochFraction)
+	assert.NotErrorIs(t, err, ErrTimeEpochOverflow)
+
+	err = n.Scan(1e30)
+	require.ErrorIs(t, err, ErrTimeEpochOverflow)
+
+	err = n.Scan(math.NaN())
+	require.ErrorIs(t, err, ErrTimeEpochOverflow)
+
+	require.NoError(t, n.Scan(1500.0))
+	assert.Equal(t, time.UnixMilli(1500).UTC(), n.ValueOrZero())
+
+	n = NewTime(ZeroTime, false, WithTimeEpoch(TimeEpochMillis))
+
+	n = NewTime(ZeroTime, false, WithTimeEpoch(TimeEpochFloatSeconds))
+	err = n.UnmarshalJSON([]byte("1e30"))
+	require.ErrorIs(t, err, ErrTimeEpochOverflow)
+
+	err = n.UnmarshalText([]byte("1.5x"))
+	require.ErrorIs(t, err, strconv.ErrSyntax)
+
+	assert.False(t, n.IsValid())
+}
+
+func TestTimeValueModes(t *testing.T) {
+	location := time.FixedZone("UTC-7", -7*60*60)
+	value := time.Date(2006, 1, 2, 15, 4, 5, 0, location)
+
+	driverValue, err := TimeFrom(value).Value()
+	require.NoError(t, err)
+	assert.Equal(t, value, driverValue)
+
+	driverValue, err = TimeFrom(value, WithTimeValueLayout()).Value()
+	require.NoError(t, err)
+	assert.Equal(t, "2006-01-02T15:04:05-07:00", driverValue)
+
+	driverValue, err = TimeFrom(
+		value,
+		WithTimeLayout(time.DateTime),
+		WithTimeValueLayout(),
+		WithTimeValueLocation(time.UTC),
+	).Value()
+	require.NoError(t, err)
+	assert.Equal(t, "2006-01-02 22:04:05", driverValue)
+
+	driverValue, err = TimeFrom(value, WithTimeValueUnix()).Value()
+	require.NoError(t, err)
+	assert.Equal(t, value.Unix(), driverValue)
+
+	driverValue, err = TimeFrom(value, WithTimeValueLocation(time.UTC)).Value()
+	require.NoError(t, err)
+	assert.Equal(t, value.UTC(), driverValue)
+
+	driverValue, err = TimeFrom(value, WithTimeValueLayout(), WithTimeValueNative()).Value()
+	require.NoError(t, err)
+	assert.Equal(t, value, driverValue)
+
+	driverValue, err = NewTime(value, false, WithTimeValueLayout()).Value()
+	require.NoError(t, err)
+	assert.Nil(t, driverValue)
+}
+
+func TestTimeValueTags(t *testing.T) {
+	var document struct {
+		Created Time `null:"layout=DateTime,value=layout,valuelocation=UTC"`
+		Updated Time `null:"value=unix"`
+		Invalid Time `null:"valuelocation=Nowhere/Unknown"`
+	}
+
+	err := ApplyTags(&document)
+	var tagErr TagError
+	require.ErrorAs(t, err, &tagErr)
+	assert.Equal(t, "Invalid", tagErr.Field())
+
+	document.Created.SetValue(time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", 3600)))
+	driverValue, err := document.Created.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "2006-01-02 14:04:05", driverValue)
+
+	document.Updated.SetValue(time.Unix(1, 0))
+	driverValue, err = document.Updated.Value()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), driverValue)
+
+	// valuelocation only converts the driver value, not the time itself.
+	_, offset := document.Created.ValueOrZero().Zone()
+	assert.Equal(t, 3600, offset)
+}
+
+func TestTimeNormalization(t *testing.T) {
+	zone := time.FixedZone("", 3600)
+	value := time.Date(2006, 1, 2, 15, 4, 5, 123456789, zone)
+
+	created := TimeFrom(
+		value, WithTimeUTC(), WithTimeTruncate(time.Microsecond), WithTimeRFC3339Nano(),
+	)
+	assert.Equal(t, time.UTC, created.ValueOrZero().Location())
+	assert.Equal(t, 123456000, created.ValueOrZero().Nanosecond())
+
+	data, err := json.Marshal(created)
+	require.NoError(t, err)
+	assert.Equal(t, `"2006-01-02T14:04:05.123456Z"`, string(data))
+
+	driverValue, err := created.Value()
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2006, 1, 2, 14, 4, 5, 123456000, time.UTC), driverValue)
+
+	scanned := NewTime(ZeroTime, false, WithTimeUTC(), WithTimeTruncate(time.Microsecond))
+	require.NoError(t, scanned.Scan(value))
+	assert.Equal(t, created.ValueOrZero(), scanned.ValueOrZero())
+
+	unmarshaled := NewTime(ZeroTime, false, WithTimeLocation(zone), WithTimeTruncate(time.Second))
+	require.NoError(t, json.Unmarshal([]byte(`"2006-01-02T14:04:05.999Z"`), &unmarshaled))
+	assert.Equal(t, "2006-01-02T15:04:05+01:00", unmarshaled.FormatLayout())
+
+	require.NoError(t, unmarshaled.UnmarshalText([]byte("2006-01-02T14:04:05.5Z")))
+	assert.Equal(t, 0, unmarshaled.ValueOrZero().Nanosecond())
+	assert.Equal(t, zone, unmarshaled.ValueOrZero().Location())
+}
+
+func TestTimeEqual(t *testing.T) {
+	value := time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", 3600))
+
+	assert.True(t, TimeFrom(value).Equal(TimeFrom(value.UTC()).NullableImpl))
+	now := time.Now()
+	assert.True(t, TimeFrom(now).Equal(From(now.Round(0))))
+	assert.False(t, TimeFrom(value).Equal(TimeFrom(value.Add(time.Nanosecond)).NullableImpl))
+	assert.False(t, TimeFrom(value).Equal(NewTime(value, false).NullableImpl))
+	assert.False(t, NewTime(value, false).Equal(NewTime(value, false).NullableImpl))
+}
+
+func TestTimeNormalizationTags(t *testing.T) {
+	var document struct {
+		Created Time `null:"utc,truncate=1ms"`
+		Updated Time `null:"location=Europe/Amsterdam"`
+		Invalid Time `null:"truncate=soon"`
+	}
+
+	err := ApplyTags(&document)
+	var tagErr TagError
+	require.ErrorAs(t, err, &tagErr)
+	assert.Equal(t, "Invalid", tagErr.Field())
+
+	var unknown struct {
+		Created Time `null:"location=Nowhere/Unknown"`
+	}
+
+	require.ErrorAs(t, ApplyTags(&unknown), &tagErr)
+	assert.Equal(t, "Created", tagErr.Field())
+
+	var removed struct {
+		Created Time `null:"timezone=UTC"`
+	}
+
+	require.ErrorIs(t, ApplyTags(&removed), ErrTagOptionUnsupported)
+
+	require.NoError(t, document.Created.UnmarshalText([]byte("2006-01-02T15:04:05.123456+01:00")))
+	expected := time.Date(2006, 1, 2, 14, 4, 5, 123000000, time.UTC)
+	assert.Equal(t, expected, document.Created.ValueOrZero())
+
+	require.NoError(t, document.Updated.UnmarshalText([]byte("2006-01-02T14:04:05Z")))
+	assert.Equal(t, "2006-01-02T15:04:05+01:00", document.Updated.FormatLayout())
+}
+
+func TestTimeSQLiteErrors(t *testing.T) {
+	for _, src := range []any{math.NaN(), -1.0, "yesterday", []byte("2006-13-02"), true} {
+		value := NewTime(ZeroTime, false, WithTimeSQLite(SQLiteTimeText))
+		err := value.Scan(src)
+
+		var scannerErr ScannerError
+		require.ErrorAs(t, err, &scannerErr, "%v", src)
+		assert.False(t, value.IsValid())
+	}
+
+	value := NewTime(ZeroTime, false, WithTimeSQLite(SQLiteTimeText))
+	require.ErrorIs(t, value.Scan(math.Inf(1)), ErrInvalidSQLiteTime)
+	require.ErrorIs(t, value.Scan(true), ErrCannotScan)
+
+	require.NoError(t, value.Scan(nil))
+	assert.False(t, value.IsValid())
+
+	driverValue, err := TimeFrom(time.Unix(0, 0), WithTimeSQLite(SQLiteTimeJulianDay)).Value()
+	require.NoError(t, err)
+	assert.InDelta(t, 2440587.5, driverValue, 0)
+
+	second := time.Unix(1, 0).UTC()
+	layout := TimeFrom(second, WithTimeSQLite(SQLiteTimeUnix), WithTimeValueLayout())
+	driverValue, err = layout.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "1970-01-01T00:00:01Z", driverValue)
+
+	driverValue, err = TimeFrom(second, WithTimeSQLite(SQLiteTimeNone)).Value()
+	require.NoError(t, err)
+	assert.Equal(t, second, driverValue)
+}
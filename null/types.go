@@ -6,6 +6,8 @@ import (
 	"github.com/google/uuid"
 )
 
+// The variables below are the literals of the encodings and are read without synchronization,
+// so they must not be changed. Use Config for the settings that can differ between callers.
 var (
 	// ZeroString is the zero value for the string type.
 	ZeroString = ""
@@ -17,9 +19,15 @@ var (
 	ZeroIntegerString = "0"
 
 	// TrueString is a string representation of true.
+	//
+	// Deprecated: Use Config.TrueText to change the text representation of a Bool.
+	// It must not be changed concurrently with the use of any value, see DefaultConfig.
 	TrueString = "true"
 
 	// FalseString is a string representation of false.
+	//
+	// Deprecated: Use Config.FalseText to change the text representation of a Bool.
+	// It must not be changed concurrently with the use of any value, see DefaultConfig.
 	FalseString = "false"
 
 	// ZeroUUIDString is a string representation of an empty uuid with all zeros.
@@ -296,16 +296,24 @@ func TestUint16IsZero(t *testing.T) {
 	)
 
 	zero := NewUint16(0, true)
-	assert.True(
+	assert.False(
 		t,
 		zero.IsZero(),
 	)
+	assert.True(
+		t,
+		zero.IsZeroValue(),
+	)
 
 	null := NewUint16(0, false)
 	assert.True(
 		t,
 		null.IsZero(),
 	)
+	assert.True(
+		t,
+		null.IsNull(),
+	)
 }
 
 func TestUint16SetValue(t *testing.T) {
@@ -368,3 +376,23 @@ func TestUint16Scan(t *testing.T) {
 		null,
 	)
 }
+
+func TestUint16MarshalXML(t *testing.T) {
+	testData := newUint16Data()
+	assertXMLRoundTrip(t, Uint16From(testData.Value), testData.String, Uint16{})
+	assertXMLNull(t, Uint16{}, Uint16{})
+}
+
+func TestUint16MarshalYAML(t *testing.T) {
+	testData := newUint16Data()
+	assertYAMLRoundTrip(t, Uint16From(testData.Value), testData.String, Uint16{})
+	assertYAMLNull(t, Uint16{}, Uint16From(testData.Value))
+}
+
+func TestUint16MarshalBinary(t *testing.T) {
+	testData := newUint16Data()
+	assertBinaryRoundTrip(t, Uint16From(testData.Value))
+	assertBinaryRoundTrip(t, Uint16From(testData.Value, WithInt64Valuer()))
+	assertBinaryRoundTrip(t, NewUint16(0, false, WithUint8Valuer()))
+	assertBinaryRoundTrip(t, Uint16{})
+}
@@ -296,16 +296,24 @@ func TestUint32IsZero(t *testing.T) {
 	)
 
 	zero := NewUint32(0, true)
-	assert.True(
+	assert.False(
 		t,
 		zero.IsZero(),
 	)
+	assert.True(
+		t,
+		zero.IsZeroValue(),
+	)
 
 	null := NewUint32(0, false)
 	assert.True(
 		t,
 		null.IsZero(),
 	)
+	assert.True(
+		t,
+		null.IsNull(),
+	)
 }
 
 func TestUint32SetValue(t *testing.T) {
@@ -368,3 +376,23 @@ func TestUint32Scan(t *testing.T) {
 		null,
 	)
 }
+
+func TestUint32MarshalXML(t *testing.T) {
+	testData := newUint32Data()
+	assertXMLRoundTrip(t, Uint32From(testData.Value), testData.String, Uint32{})
+	assertXMLNull(t, Uint32{}, Uint32{})
+}
+
+func TestUint32MarshalYAML(t *testing.T) {
+	testData := newUint32Data()
+	assertYAMLRoundTrip(t, Uint32From(testData.Value), testData.String, Uint32{})
+	assertYAMLNull(t, Uint32{}, Uint32From(testData.Value))
+}
+
+func TestUint32MarshalBinary(t *testing.T) {
+	testData := newUint32Data()
+	assertBinaryRoundTrip(t, Uint32From(testData.Value))
+	assertBinaryRoundTrip(t, Uint32From(testData.Value, WithInt64Valuer()))
+	assertBinaryRoundTrip(t, NewUint32(0, false, WithUint8Valuer()))
+	assertBinaryRoundTrip(t, Uint32{})
+}
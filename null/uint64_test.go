@@ -296,16 +296,24 @@ func TestUint64IsZero(t *testing.T) {
 	)
 
 	zero := NewUint64(0, true)
-	assert.True(
+	assert.False(
 		t,
 		zero.IsZero(),
 	)
+	assert.True(
+		t,
+		zero.IsZeroValue(),
+	)
 
 	null := NewUint64(0, false)
 	assert.True(
 		t,
 		null.IsZero(),
 	)
+	assert.True(
+		t,
+		null.IsNull(),
+	)
 }
 
 func TestUint64SetValue(t *testing.T) {
@@ -368,3 +376,23 @@ func TestUint64Scan(t *testing.T) {
 		null,
 	)
 }
+
+func TestUint64MarshalXML(t *testing.T) {
+	testData := newUint64Data()
+	assertXMLRoundTrip(t, Uint64From(testData.Value), testData.String, Uint64{})
+	assertXMLNull(t, Uint64{}, Uint64{})
+}
+
+func TestUint64MarshalYAML(t *testing.T) {
+	testData := newUint64Data()
+	assertYAMLRoundTrip(t, Uint64From(testData.Value), testData.String, Uint64{})
+	assertYAMLNull(t, Uint64{}, Uint64From(testData.Value))
+}
+
+func TestUint64MarshalBinary(t *testing.T) {
+	testData := newUint64Data()
+	assertBinaryRoundTrip(t, Uint64From(testData.Value))
+	assertBinaryRoundTrip(t, Uint64From(testData.Value, WithInt64Valuer()))
+	assertBinaryRoundTrip(t, NewUint64(0, false, WithUint8Valuer()))
+	assertBinaryRoundTrip(t, Uint64{})
+}
@@ -296,16 +296,24 @@ func TestUint8IsZero(t *testing.T) {
 	)
 
 	zero := NewUint8(0, true)
-	assert.True(
+	assert.False(
 		t,
 		zero.IsZero(),
 	)
+	assert.True(
+		t,
+		zero.IsZeroValue(),
+	)
 
 	null := NewUint8(0, false)
 	assert.True(
 		t,
 		null.IsZero(),
 	)
+	assert.True(
+		t,
+		null.IsNull(),
+	)
 }
 
 func TestUint8SetValue(t *testing.T) {
@@ -368,3 +376,23 @@ func TestUint8Scan(t *testing.T) {
 		null,
 	)
 }
+
+func TestUint8MarshalXML(t *testing.T) {
+	testData := newUint8Data()
+	assertXMLRoundTrip(t, Uint8From(testData.Value), testData.String, Uint8{})
+	assertXMLNull(t, Uint8{}, Uint8{})
+}
+
+func TestUint8MarshalYAML(t *testing.T) {
+	testData := newUint8Data()
+	assertYAMLRoundTrip(t, Uint8From(testData.Value), testData.String, Uint8{})
+	assertYAMLNull(t, Uint8{}, Uint8From(testData.Value))
+}
+
+func TestUint8MarshalBinary(t *testing.T) {
+	testData := newUint8Data()
+	assertBinaryRoundTrip(t, Uint8From(testData.Value))
+	assertBinaryRoundTrip(t, Uint8From(testData.Value, WithInt64Valuer()))
+	assertBinaryRoundTrip(t, NewUint8(0, false, WithUint8Valuer()))
+	assertBinaryRoundTrip(t, Uint8{})
+}
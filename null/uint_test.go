@@ -296,16 +296,24 @@ func TestUintIsZero(t *testing.T) {
 	)
 
 	zero := NewUint(0, true)
-	assert.True(
+	assert.False(
 		t,
 		zero.IsZero(),
 	)
+	assert.True(
+		t,
+		zero.IsZeroValue(),
+	)
 
 	null := NewUint(0, false)
 	assert.True(
 		t,
 		null.IsZero(),
 	)
+	assert.True(
+		t,
+		null.IsNull(),
+	)
 }
 
 func TestUintSetValue(t *testing.T) {
@@ -368,3 +376,23 @@ func TestUintScan(t *testing.T) {
 		null,
 	)
 }
+
+func TestUintMarshalXML(t *testing.T) {
+	testData := newUintData()
+	assertXMLRoundTrip(t, UintFrom(testData.Value), testData.String, Uint{})
+	assertXMLNull(t, Uint{}, Uint{})
+}
+
+func TestUintMarshalYAML(t *testing.T) {
+	testData := newUintData()
+	assertYAMLRoundTrip(t, UintFrom(testData.Value), testData.String, Uint{})
+	assertYAMLNull(t, Uint{}, UintFrom(testData.Value))
+}
+
+func TestUintMarshalBinary(t *testing.T) {
+	testData := newUintData()
+	assertBinaryRoundTrip(t, UintFrom(testData.Value))
+	assertBinaryRoundTrip(t, UintFrom(testData.Value, WithInt64Valuer()))
+	assertBinaryRoundTrip(t, NewUint(0, false, WithUint8Valuer()))
+	assertBinaryRoundTrip(t, Uint{})
+}
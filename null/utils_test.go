@@ -1,8 +1,13 @@
 package null
 
 import (
+	"bytes"
 	"database/sql/driver"
+	"encoding"
+	"encoding/base64"
+	"encoding/gob"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"math"
 	"strconv"
@@ -12,6 +17,9 @@ import (
 
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 var (
@@ -65,6 +73,7 @@ type BytesData struct {
 	Value      []byte
 	Ptr        *[]byte
 	String     string
+	Base64     string
 	JSONString string
 	JSONBytes  []byte
 	Bytes      []byte
@@ -73,12 +82,14 @@ type BytesData struct {
 func newBytesData() BytesData {
 	value := []byte(gofakeit.LetterN(math.MaxInt8))
 	str := string(value)
-	JSONString := strconv.Quote(str)
+	text := base64.StdEncoding.EncodeToString(value)
+	JSONString := strconv.Quote(text)
 
 	return BytesData{
 		Value:      value,
 		Ptr:        &value,
 		String:     str,
+		Base64:     text,
 		JSONString: JSONString,
 		JSONBytes:  []byte(JSONString),
 		Bytes:      []byte(str),
@@ -633,3 +644,156 @@ func withActualAndExpectedInteger(t *testing.T, actualType any, expectedType any
 		}
 	}
 }
+
+type xmlDocument[N any] struct {
+	XMLName xml.Name `xml:"document"`
+	Attr    N        `xml:"attr,attr"`
+	Element N        `xml:"element"`
+}
+
+// assertXMLRoundTrip marshals value as both an attribute and an element, asserts the encoded
+// text and unmarshals it back into a copy of target, which must then equal value.
+func assertXMLRoundTrip[N any](t *testing.T, value N, text string, target N) {
+	t.Helper()
+
+	data, err := xml.Marshal(xmlDocument[N]{Attr: value, Element: value})
+	require.NoError(t, err)
+
+	var escaped strings.Builder
+	require.NoError(t, xml.EscapeText(&escaped, []byte(text)))
+	assert.Equal(
+		t,
+		`<document attr="`+escaped.String()+`"><element>`+escaped.String()+`</element></document>`,
+		string(data),
+	)
+
+	document := xmlDocument[N]{Attr: target, Element: target}
+	err = xml.Unmarshal(data, &document)
+	require.NoError(t, err)
+	assert.Equal(t, value, document.Attr)
+	assert.Equal(t, value, document.Element)
+}
+
+// assertXMLNull marshals null with every XMLNullMode, asserts the encoded document
+// and unmarshals it back into a copy of target, which must then equal null.
+func assertXMLNull[N any](t *testing.T, null N, target N) {
+	t.Helper()
+
+	defer defaultConfig.Store(defaultConfig.Load())
+
+	expected := map[XMLNullMode]string{
+		XMLNullOmit:  `<document></document>`,
+		XMLNullEmpty: `<document attr=""><element></element></document>`,
+		XMLNullNil: `<document><element xmlns:xsi="` + XMLSchemaInstanceNamespace +
+			`" xsi:nil="true"></element></document>`,
+	}
+
+	for mode, document := range expected {
+		config := DefaultConfig()
+		config.XMLNull = mode
+		SetDefaultConfig(config)
+
+		data, err := xml.Marshal(xmlDocument[N]{Attr: null, Element: null})
+		require.NoError(t, err)
+		assert.Equal(t, document, string(data))
+
+		decoded := xmlDocument[N]{Attr: target, Element: target}
+		err = xml.Unmarshal(data, &decoded)
+		require.NoError(t, err)
+		assert.Equal(t, null, decoded.Attr)
+		assert.Equal(t, null, decoded.Element)
+	}
+}
+
+type yamlDocument[N any] struct {
+	Value N `yaml:"value"`
+}
+
+// assertYAMLRoundTrip marshals value, asserts the encoded text and unmarshals
+// it back into a copy of target, which must then equal value.
+func assertYAMLRoundTrip[N any](t *testing.T, value N, text string, target N) {
+	t.Helper()
+
+	data, err := yaml.Marshal(yamlDocument[N]{Value: value})
+	require.NoError(t, err)
+	assert.Equal(t, "value: "+text+"\n", string(data))
+
+	document := yamlDocument[N]{Value: target}
+	err = yaml.Unmarshal(data, &document)
+	require.NoError(t, err)
+	assert.Equal(t, value, document.Value)
+}
+
+// assertYAMLNull asserts that null marshals to a YAML null, and that every YAML spelling of null
+// unmarshals through UnmarshalYAML into a struct field holding target, which must then equal null.
+func assertYAMLNull[N any](t *testing.T, null N, target N) {
+	t.Helper()
+
+	data, err := yaml.Marshal(yamlDocument[N]{Value: null})
+	require.NoError(t, err)
+	assert.Equal(t, "value: null\n", string(data))
+
+	for _, text := range []string{"~", "null", "Null", ""} {
+		document := yamlDocument[N]{Value: target}
+		err = UnmarshalYAML([]byte("value: "+text), &document)
+		require.NoError(t, err)
+		assert.Equal(t, null, document.Value, text)
+	}
+}
+
+// assertBinaryRoundTrip encodes value through encoding.BinaryMarshaler and encoding/gob,
+// and decodes it back into a new value, which must then equal value.
+func assertBinaryRoundTrip[N any, P interface {
+	*N
+	encoding.BinaryUnmarshaler
+}](t *testing.T, value N) {
+	t.Helper()
+
+	data, err := any(value).(encoding.BinaryMarshaler).MarshalBinary()
+	require.NoError(t, err)
+
+	var decoded N
+	err = P(&decoded).UnmarshalBinary(data)
+	require.NoError(t, err)
+	assert.Equal(t, value, decoded)
+
+	prefix := []byte("prefix")
+	appended, err := any(value).(interface {
+		AppendBinary([]byte) ([]byte, error)
+	}).AppendBinary(prefix)
+	require.NoError(t, err)
+	assert.Equal(t, append(prefix, data...), appended)
+
+	var buffer bytes.Buffer
+	err = gob.NewEncoder(&buffer).Encode(value)
+	require.NoError(t, err)
+
+	var gobDecoded N
+	err = gob.NewDecoder(&buffer).Decode(&gobDecoded)
+	require.NoError(t, err)
+	assert.Equal(t, value, gobDecoded)
+
+	err = P(&decoded).UnmarshalBinary(nil)
+	require.ErrorIs(t, err, ErrInvalidBinaryData)
+}
+
+// assertGobKeepsJSON encodes value with gob, decodes it into a zero value
+// and asserts that both marshal to the same JSON, so that no option is lost.
+func assertGobKeepsJSON[N any](t *testing.T, value N) {
+	t.Helper()
+
+	expected, err := json.Marshal(value)
+	require.NoError(t, err)
+
+	var buffer bytes.Buffer
+	err = gob.NewEncoder(&buffer).Encode(value)
+	require.NoError(t, err)
+
+	var decoded N
+	err = gob.NewDecoder(&buffer).Decode(&decoded)
+	require.NoError(t, err)
+
+	actual, err := json.Marshal(decoded)
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(actual))
+}
@@ -3,10 +3,13 @@ package null // import "github.com/Patrick-Batenburg/nullify/null"
 import (
 	"bytes"
 	"database/sql/driver"
+	"encoding/xml"
 	"fmt"
+	"log/slog"
 	"strconv"
 
 	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
 )
 
 // UUID is a NullableImpl uuid.UUID. It supports SQL and JSON serialization.
@@ -185,3 +188,60 @@ func newUUIDFromPtr(value any) (uuidPtr *uuid.UUID) {
 
 	return uuidPtr
 }
+
+// MarshalXML implements xml.Marshaler.
+func (n UUID) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, n.IsValid(), n.currentConfig().XMLNull, n.MarshalText)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+func (n UUID) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, n.IsValid(), n.currentConfig().XMLNull, n.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (n *UUID) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, n.UnmarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (n *UUID) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, n.UnmarshalText)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (n UUID) MarshalYAML() (any, error) {
+	if !n.IsValid() {
+		return nil, nil
+	}
+
+	return n.value.String(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (n *UUID) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAMLText(node, n, n.UnmarshalText)
+}
+
+// Set implements the flag.Value interface.
+func (n *UUID) Set(value string) error {
+	return setFlag(n, value)
+}
+
+// String implements the fmt.Stringer and flag.Value interfaces.
+func (n UUID) String() string {
+	if !n.IsValid() {
+		return n.currentConfig().NullText
+	}
+
+	return textString(n.MarshalText)
+}
+
+// LogValue implements the slog.LogValuer interface. The UUID is logged as a string.
+func (n UUID) LogValue() slog.Value {
+	if !n.IsValid() {
+		return slog.AnyValue(nil)
+	}
+
+	return slog.StringValue(n.value.String())
+}
@@ -2,6 +2,7 @@ package null
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"strconv"
 	"testing"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestNewUUID(t *testing.T) {
@@ -333,16 +335,24 @@ func TestUUIDIsZero(t *testing.T) {
 	)
 
 	zero := NewUUID(ZeroUUIDString, true)
-	assert.True(
+	assert.False(
 		t,
 		zero.IsZero(),
 	)
+	assert.True(
+		t,
+		zero.IsZeroValue(),
+	)
 
 	null := NewUUID(ZeroUUIDString, false)
 	assert.True(
 		t,
 		null.IsZero(),
 	)
+	assert.True(
+		t,
+		null.IsNull(),
+	)
 }
 
 func TestUUIDSetValue(t *testing.T) {
@@ -405,3 +415,28 @@ func TestUUIDScan(t *testing.T) {
 		null,
 	)
 }
+
+func TestUUIDMarshalXML(t *testing.T) {
+	testData := newUUIDData()
+	assertXMLRoundTrip(t, UUIDFrom(testData.Value), testData.String, UUID{})
+	assertXMLNull(t, UUID{}, UUID{})
+
+	var badType UUID
+	err := xml.Unmarshal([]byte(`<uuid>:)</uuid>`), &badType)
+	require.ErrorIs(t, err, ErrCannotUnmarshal)
+}
+
+func TestUUIDMarshalYAML(t *testing.T) {
+	testData := newUUIDData()
+	assertYAMLRoundTrip(t, UUIDFrom(testData.Value), testData.String, UUID{})
+	assertYAMLNull(t, UUID{}, UUIDFrom(testData.Value))
+
+	err := yaml.Unmarshal([]byte("value: :)"), &yamlDocument[UUID]{})
+	require.ErrorIs(t, err, ErrCannotUnmarshal)
+}
+
+func TestUUIDMarshalBinary(t *testing.T) {
+	testData := newUUIDData()
+	assertBinaryRoundTrip(t, UUIDFrom(testData.Value))
+	assertBinaryRoundTrip(t, UUID{})
+}
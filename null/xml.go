@@ -0,0 +1,141 @@
+package null // import "github.com/Patrick-Batenburg/nullify/null"
+
+import (
+	"encoding/xml"
+)
+
+// XMLNullMode determines how an invalid value is represented by xml.Marshaler.
+type XMLNullMode int
+
+const (
+	// XMLNullOmit omits the element or attribute of an invalid value.
+	XMLNullOmit XMLNullMode = iota
+
+	// XMLNullEmpty writes an empty element or attribute for an invalid value.
+	XMLNullEmpty
+
+	// XMLNullNil writes an empty element with xsi:nil="true" for an invalid value.
+	// Attributes cannot be nil, so they are omitted instead.
+	XMLNullNil
+)
+
+// XMLSchemaInstanceNamespace is the namespace of the xsi:nil attribute.
+const XMLSchemaInstanceNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+// MarshalXML implements the xml.Marshaler interface.
+func (n NullableImpl[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(e, start, n.IsValid(), n.currentConfig().XMLNull, n.MarshalText)
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface.
+func (n NullableImpl[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, n.IsValid(), n.currentConfig().XMLNull, n.MarshalText)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface.
+func (n *NullableImpl[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXML(d, start, n.UnmarshalText)
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+func (n *NullableImpl[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	return unmarshalXMLAttr(attr, n.UnmarshalText)
+}
+
+// marshalXML encodes the text returned by marshalText as the character data of start.
+// If the value is invalid then it is encoded according to mode.
+func marshalXML(
+	e *xml.Encoder,
+	start xml.StartElement,
+	valid bool,
+	mode XMLNullMode,
+	marshalText func() ([]byte, error),
+) error {
+	if !valid {
+		switch mode {
+		case XMLNullEmpty:
+			return e.EncodeElement(ZeroString, start)
+		case XMLNullNil:
+			start.Attr = append(
+				start.Attr,
+				xml.Attr{Name: xml.Name{Local: "xmlns:xsi"}, Value: XMLSchemaInstanceNamespace},
+				xml.Attr{Name: xml.Name{Local: "xsi:nil"}, Value: TrueString},
+			)
+
+			return e.EncodeElement(ZeroString, start)
+		default:
+			return nil
+		}
+	}
+
+	text, err := marshalText()
+
+	if err != nil {
+		return err
+	}
+
+	return e.EncodeElement(string(text), start)
+}
+
+// marshalXMLAttr returns the text returned by marshalText as an attribute named name.
+// If the value is invalid then the attribute is omitted, unless mode is XMLNullEmpty.
+func marshalXMLAttr(
+	name xml.Name,
+	valid bool,
+	mode XMLNullMode,
+	marshalText func() ([]byte, error),
+) (xml.Attr, error) {
+	if !valid {
+		if mode == XMLNullEmpty {
+			return xml.Attr{Name: name}, nil
+		}
+
+		return xml.Attr{}, nil
+	}
+
+	text, err := marshalText()
+
+	if err != nil {
+		return xml.Attr{}, err
+	}
+
+	return xml.Attr{Name: name, Value: string(text)}, nil
+}
+
+// unmarshalXML decodes the character data of start through unmarshalText.
+// An element with xsi:nil="true" or without character data is decoded as null.
+func unmarshalXML(d *xml.Decoder, start xml.StartElement, unmarshalText func([]byte) error) error {
+	var text string
+
+	if err := d.DecodeElement(&text, &start); err != nil {
+		return err
+	}
+
+	if text == ZeroString || isXMLNil(start) {
+		return unmarshalText(nil)
+	}
+
+	return unmarshalText([]byte(text))
+}
+
+// unmarshalXMLAttr decodes the value of attr through unmarshalText.
+// An empty attribute is decoded as null.
+func unmarshalXMLAttr(attr xml.Attr, unmarshalText func([]byte) error) error {
+	if attr.Value == ZeroString {
+		return unmarshalText(nil)
+	}
+
+	return unmarshalText([]byte(attr.Value))
+}
+
+// isXMLNil returns true if start has an xsi:nil="true" attribute.
+func isXMLNil(start xml.StartElement) bool {
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "nil" &&
+			(attr.Name.Space == XMLSchemaInstanceNamespace || attr.Name.Space == "xsi") {
+			return attr.Value == TrueString || attr.Value == "1"
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,229 @@
+package null // import "github.com/Patrick-Batenburg/nullify/null"
+
+import (
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// yamlNullTag is the resolved tag of a null node, such as ~, null or an empty value.
+const yamlNullTag = "!!null"
+
+// MarshalYAML implements the yaml.Marshaler interface.
+func (n NullableImpl[T]) MarshalYAML() (any, error) {
+	if !n.IsValid() {
+		return nil, nil
+	}
+
+	return n.value, nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (n *NullableImpl[T]) UnmarshalYAML(node *yaml.Node) error {
+	if isYAMLNull(node) {
+		var zero T
+		n.value = zero
+		n.valid = false
+
+		return nil
+	}
+
+	err := node.Decode(&n.value)
+
+	if err != nil {
+		return NewUnmarshalError(node.Value, n, err)
+	}
+
+	n.valid = true
+
+	return nil
+}
+
+// unmarshalYAMLText decodes a scalar node through unmarshalText.
+// A null node is passed to unmarshalText as nil.
+func unmarshalYAMLText(node *yaml.Node, targetType any, unmarshalText func([]byte) error) error {
+	if isYAMLNull(node) {
+		return unmarshalText(nil)
+	}
+
+	if node.Kind != yaml.ScalarNode {
+		return NewUnmarshalError(node.Value, targetType, ErrCannotUnmarshal)
+	}
+
+	return unmarshalText([]byte(node.Value))
+}
+
+// isYAMLNull returns true if node is empty or resolves to null.
+// Note that yaml.v3 does not call yaml.Unmarshaler for null nodes when decoding
+// into a struct field, it leaves the field untouched instead, see UnmarshalYAML.
+func isYAMLNull(node *yaml.Node) bool {
+	return node == nil ||
+		node.Kind == 0 ||
+		(node.Kind == yaml.ScalarNode && node.ShortTag() == yamlNullTag)
+}
+
+// UnmarshalYAML parses the YAML-encoded data and stores the result in the value pointed to by dest.
+//
+// yaml.Unmarshal does not call yaml.Unmarshaler for null nodes, such as ~, null and empty values,
+// so it leaves nullable fields that already hold a value untouched, and Optional fields absent.
+// UnmarshalYAML unmarshals the null nodes of nullable fields afterwards, so that they become invalid,
+// and Optional fields become explicitly null, including the fields of nested structs and
+// the elements of slices and pointers. Null elements of slices keep their position,
+// where yaml.Unmarshal drops them. Like Unmarshal, the TagName options are applied
+// before and after decoding.
+func UnmarshalYAML(data []byte, dest any) error {
+	if err := ApplyTags(dest); err != nil {
+		return err
+	}
+
+	var node yaml.Node
+
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return err
+	}
+
+	if err := node.Decode(dest); err != nil {
+		return err
+	}
+
+	if err := unmarshalYAMLNulls(&node, reflect.ValueOf(dest)); err != nil {
+		return err
+	}
+
+	return ApplyTags(dest)
+}
+
+// unmarshalYAMLNulls walks node along with value and unmarshals the null nodes of nullable values,
+// which yaml.v3 skipped.
+func unmarshalYAMLNulls(node *yaml.Node, value reflect.Value) error {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil
+		}
+
+		return unmarshalYAMLNulls(node.Content[0], value)
+	case yaml.AliasNode:
+		return unmarshalYAMLNulls(node.Alias, value)
+	}
+
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return nil
+		}
+
+		value = value.Elem()
+	}
+
+	if value.Kind() == reflect.Struct && value.CanAddr() {
+		if _, ok := value.Addr().Interface().(configBinder); ok {
+			unmarshaler, ok := value.Addr().Interface().(yaml.Unmarshaler)
+
+			if ok && isYAMLNull(node) {
+				return unmarshaler.UnmarshalYAML(node)
+			}
+
+			return nil
+		}
+	}
+
+	switch {
+	case node.Kind == yaml.MappingNode && value.Kind() == reflect.Struct:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			field, ok := yamlField(value, node.Content[i].Value)
+
+			if !ok {
+				continue
+			}
+
+			if err := unmarshalYAMLNulls(node.Content[i+1], field); err != nil {
+				return err
+			}
+		}
+	case node.Kind == yaml.SequenceNode && value.Kind() == reflect.Slice:
+		if value.Len() != len(node.Content) {
+			// yaml.v3 drops the null elements that it cannot decode, such as nullable structs.
+			return unmarshalYAMLSequence(node, value)
+		}
+
+		for i := range value.Len() {
+			if err := unmarshalYAMLNulls(node.Content[i], value.Index(i)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// unmarshalYAMLSequence decodes the elements of the sequence node one by one into a new slice,
+// which replaces the slice value, so that null elements keep their position.
+func unmarshalYAMLSequence(node *yaml.Node, value reflect.Value) error {
+	items := reflect.MakeSlice(value.Type(), len(node.Content), len(node.Content))
+
+	for i, item := range node.Content {
+		if !isYAMLNull(item) {
+			if err := item.Decode(items.Index(i).Addr().Interface()); err != nil {
+				return err
+			}
+		}
+
+		if err := unmarshalYAMLNulls(item, items.Index(i)); err != nil {
+			return err
+		}
+	}
+
+	value.Set(items)
+
+	return nil
+}
+
+// yamlField returns the field of the struct value that yaml.v3 decodes the mapping key into,
+// which is named by its yaml tag or else by its lowercased name, including the fields of inlined structs.
+func yamlField(value reflect.Value, key string) (reflect.Value, bool) {
+	for i := range value.NumField() {
+		field := value.Type().Field(i)
+		name, options, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+
+		if name == "-" || (!field.IsExported() && !field.Anonymous) {
+			continue
+		}
+
+		if strings.Contains(","+options+",", ",inline,") {
+			fieldValue := value.Field(i)
+
+			if fieldValue.Kind() == reflect.Pointer {
+				if fieldValue.IsNil() {
+					continue
+				}
+
+				fieldValue = fieldValue.Elem()
+			}
+
+			if fieldValue.Kind() != reflect.Struct {
+				continue
+			}
+
+			if inlined, ok := yamlField(fieldValue, key); ok {
+				return inlined, true
+			}
+
+			continue
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == ZeroString {
+			name = strings.ToLower(field.Name)
+		}
+
+		if name == key {
+			return value.Field(i), true
+		}
+	}
+
+	return reflect.Value{}, false
+}
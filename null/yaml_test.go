@@ -0,0 +1,95 @@
+package null // import "github.com/Patrick-Batenburg/nullify/null"
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+type yamlNullsEmbedded struct {
+	Inline Int `yaml:"inline"`
+}
+
+type yamlNullsDocument struct {
+	yamlNullsEmbedded `yaml:",inline"`
+
+	Count     Int    `yaml:"count"`
+	Renamed   String `yaml:"name"`
+	Lower     Float64
+	Created   Time                 `yaml:"created" null:"layout=DateOnly"`
+	Items     []Int                `yaml:"items"`
+	Pointer   *Bool                `yaml:"pointer"`
+	Nested    struct{ Value UUID } `yaml:"nested"`
+	Skipped   Int                  `yaml:"-"`
+	Untouched Int                  `yaml:"untouched"`
+}
+
+func TestUnmarshalYAMLPlainLeavesNullsUntouched(t *testing.T) {
+	document := yamlDocument[Int]{Value: IntFrom(5)}
+	err := yaml.Unmarshal([]byte("value: ~"), &document)
+	require.NoError(t, err)
+	assert.Equal(t, IntFrom(5), document.Value)
+
+	err = UnmarshalYAML([]byte("value: ~"), &document)
+	require.NoError(t, err)
+	assert.False(t, document.Value.IsValid())
+}
+
+func TestUnmarshalYAML(t *testing.T) {
+	document := yamlNullsDocument{
+		yamlNullsEmbedded: yamlNullsEmbedded{Inline: IntFrom(1)},
+		Count:             IntFrom(2),
+		Renamed:           StringFrom("name"),
+		Lower:             Float64From(3),
+		Items:             []Int{IntFrom(4), IntFrom(5)},
+		Pointer:           &Bool{},
+		Skipped:           IntFrom(6),
+		Untouched:         IntFrom(7),
+	}
+	*document.Pointer = BoolFrom(true)
+	document.Nested.Value = UUIDFrom(newUUIDData().Value)
+
+	data := `
+inline: ~
+count: null
+name:
+lower: ~
+created: 2024-02-29
+items: [~, 8, null]
+pointer: ~
+nested:
+  value: ~
+skipped: ~
+`
+	err := UnmarshalYAML([]byte(data), &document)
+	require.NoError(t, err)
+
+	assert.False(t, document.Inline.IsValid())
+	assert.False(t, document.Count.IsValid())
+	assert.False(t, document.Renamed.IsValid())
+	assert.False(t, document.Lower.IsValid())
+	assert.Equal(t, "2024-02-29", document.Created.FormatLayout(WithTimeLayoutFormat()))
+	assert.Equal(t, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), document.Created.ValueOrZero())
+	assert.Equal(t, []Int{{}, IntFrom(8), {}}, document.Items)
+	assert.Nil(t, document.Pointer)
+	assert.False(t, document.Nested.Value.IsValid())
+	assert.Equal(t, IntFrom(6), document.Skipped)
+	assert.Equal(t, IntFrom(7), document.Untouched)
+}
+
+func TestUnmarshalYAMLErrors(t *testing.T) {
+	var document yamlDocument[Int]
+
+	require.ErrorIs(t, UnmarshalYAML([]byte("value: ~"), document), ErrInvalidDestination)
+	require.Error(t, UnmarshalYAML([]byte("value: [1"), &document))
+
+	var tagged struct {
+		Value Int `null:"valuer=float"`
+	}
+
+	var tagErr TagError
+	require.ErrorAs(t, UnmarshalYAML([]byte("value: 1"), &tagged), &tagErr)
+}
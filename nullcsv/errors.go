@@ -0,0 +1,39 @@
+package nullcsv // import "github.com/Patrick-Batenburg/nullify/nullcsv"
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Patrick-Batenburg/nullify/internal/structfield"
+)
+
+var (
+	ErrNotStruct       = errors.New("nullcsv: type must be a struct")
+	ErrUnsupportedType = fmt.Errorf("nullcsv: %w", structfield.ErrUnsupportedType)
+)
+
+// FieldError represents an error that occurs while decoding or encoding a single cell.
+// It wraps a null.UnmarshalError or null.MarshalError.
+type FieldError struct {
+	// Line is the line of the cell in the input when reading, or
+	// the number of the record being written, including the header, when writing.
+	Line int
+
+	// Column is the 1-based position of the cell within its record.
+	Column int
+
+	// Field is the column name of the cell.
+	Field string
+
+	err error
+}
+
+// Error returns the string representation of the FieldError.
+func (e FieldError) Error() string {
+	return fmt.Sprintf("nullcsv: line %d, column %d (%s): %v", e.Line, e.Column, e.Field, e.err)
+}
+
+// Unwrap returns the underlying error for unwrapping.
+func (e FieldError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,48 @@
+package nullcsv // import "github.com/Patrick-Batenburg/nullify/nullcsv"
+
+import (
+	"reflect"
+
+	"github.com/Patrick-Batenburg/nullify/internal/structfield"
+)
+
+// tagName is the struct tag used to name the column of a field.
+const tagName = "csv"
+
+// structFields returns the columns of the struct type t.
+// Exported fields are named by their csv tag or, if untagged, by their field name.
+// Fields tagged with "-" are skipped and untagged embedded structs are flattened.
+func structFields(t reflect.Type) ([]structfield.Field, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, ErrNotStruct
+	}
+
+	return structfield.Fields(t, tagName), nil
+}
+
+// decodeCell decodes cell into the addressable value.
+// A cell equal to nullToken sets value to null.
+// Cells that a nullable type would decode as null, such as "" or "null", are decoded as values instead.
+func decodeCell(value reflect.Value, cell string, nullToken string) error {
+	if cell == nullToken {
+		return structfield.SetNull(value)
+	}
+
+	return structfield.DecodeText(value, cell, ErrUnsupportedType)
+}
+
+// encodeCell encodes value into a cell.
+// Nil pointers and invalid nullable values are encoded as nullToken.
+func encodeCell(value reflect.Value, nullToken string) (string, error) {
+	cell, valid, err := structfield.EncodeText(value, ErrUnsupportedType)
+
+	if err != nil {
+		return "", err
+	}
+
+	if !valid {
+		return nullToken, nil
+	}
+
+	return cell, nil
+}
@@ -0,0 +1,68 @@
+package nullcsv // import "github.com/Patrick-Batenburg/nullify/nullcsv"
+
+import (
+	"github.com/Patrick-Batenburg/nullify/null"
+)
+
+// options holds the configuration shared by Reader and Writer.
+type options struct {
+	nullToken string
+	header    bool
+	comma     rune
+	config    *null.Config
+}
+
+// OptionFn is a type alias for a function that modifies the options of a Reader or Writer.
+type OptionFn = func(*options)
+
+// newOptions returns the default options with opts applied.
+func newOptions(opts ...OptionFn) options {
+	option := options{
+		header: true,
+		comma:  ',',
+	}
+
+	for _, opt := range opts {
+		opt(&option)
+	}
+
+	return option
+}
+
+// WithNullToken sets the cell value that represents null, such as "NULL", `\N` or "-".
+// Invalid values are written as token and cells equal to token are read as null.
+// Any other cell, including an empty one, is read as a value. The default token is "".
+func WithNullToken(token string) OptionFn {
+	return func(option *options) {
+		option.nullToken = token
+	}
+}
+
+// WithHeader maps the columns by the header in the first record. This is the default.
+func WithHeader() OptionFn {
+	return func(option *options) {
+		option.header = true
+	}
+}
+
+// WithoutHeader maps the columns by the order of the struct fields and
+// neither reads nor writes a header record.
+func WithoutHeader() OptionFn {
+	return func(option *options) {
+		option.header = false
+	}
+}
+
+// WithComma sets the field delimiter. The default delimiter is ','.
+func WithComma(comma rune) OptionFn {
+	return func(option *options) {
+		option.comma = comma
+	}
+}
+
+// WithConfig binds config to the nullable fields of the destination of every record before decoding it, see null.Config.Apply.
+func WithConfig(config null.Config) OptionFn {
+	return func(option *options) {
+		option.config = &config
+	}
+}
@@ -0,0 +1,171 @@
+package nullcsv // import "github.com/Patrick-Batenburg/nullify/nullcsv"
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"iter"
+	"reflect"
+
+	"github.com/Patrick-Batenburg/nullify/internal/structfield"
+)
+
+// Reader decodes CSV records into values of the struct type T.
+// Cells are decoded through encoding.TextUnmarshaler, which all null types implement.
+type Reader[T any] struct {
+	reader  *csv.Reader
+	options options
+	fields  []structfield.Field
+	columns []int
+	header  []string
+	err     error
+	started bool
+}
+
+// NewReader returns a Reader that reads records from r.
+func NewReader[T any](r io.Reader, opts ...OptionFn) *Reader[T] {
+	option := newOptions(opts...)
+	reader := csv.NewReader(r)
+	reader.Comma = option.comma
+	reader.ReuseRecord = true
+
+	return &Reader[T]{
+		reader:  reader,
+		options: option,
+	}
+}
+
+// Header returns the header record. It is nil until the first call to Read or
+// if the Reader was created with WithoutHeader.
+func (r *Reader[T]) Header() []string {
+	return r.header
+}
+
+// Read reads and decodes the next record. It returns io.EOF if there are no more records.
+// Decoding errors are returned as a FieldError, after which reading may continue with the next record.
+func (r *Reader[T]) Read() (T, error) {
+	var value T
+
+	if err := r.start(); err != nil {
+		return value, err
+	}
+
+	record, err := r.reader.Read()
+
+	if err != nil {
+		return value, err
+	}
+
+	if r.options.config != nil {
+		if err := r.options.config.Apply(&value); err != nil {
+			return value, err
+		}
+	}
+
+	target := reflect.ValueOf(&value).Elem()
+
+	for i, cell := range record {
+		if i >= len(r.columns) || r.columns[i] < 0 {
+			continue
+		}
+
+		item := r.fields[r.columns[i]]
+
+		err := decodeCell(target.FieldByIndex(item.Index), cell, r.options.nullToken)
+
+		if err != nil {
+			line, _ := r.reader.FieldPos(i)
+
+			return value, FieldError{
+				Line:   line,
+				Column: i + 1,
+				Field:  item.Name,
+				err:    err,
+			}
+		}
+	}
+
+	return value, nil
+}
+
+// ReadAll reads and decodes all remaining records.
+func (r *Reader[T]) ReadAll() ([]T, error) {
+	var values []T
+
+	for value, err := range r.All() {
+		if err != nil {
+			return values, err
+		}
+
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+// All returns an iterator over the remaining records and their decoding errors.
+// The iteration stops at the end of the input or at an error that is not a FieldError.
+func (r *Reader[T]) All() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for {
+			value, err := r.Read()
+
+			if errors.Is(err, io.EOF) {
+				return
+			}
+
+			if !yield(value, err) || (err != nil && !errors.As(err, new(FieldError))) {
+				return
+			}
+		}
+	}
+}
+
+// start resolves the columns of T and reads the header record on the first call.
+func (r *Reader[T]) start() error {
+	if r.started {
+		return r.err
+	}
+
+	r.started = true
+	r.fields, r.err = structFields(reflect.TypeFor[T]())
+
+	if r.err != nil {
+		return r.err
+	}
+
+	if !r.options.header {
+		r.columns = make([]int, len(r.fields))
+
+		for i := range r.fields {
+			r.columns[i] = i
+		}
+
+		return nil
+	}
+
+	header, err := r.reader.Read()
+
+	if err != nil {
+		r.err = err
+
+		return err
+	}
+
+	r.header = append([]string{}, header...)
+	r.columns = make([]int, len(r.header))
+
+	for i, name := range r.header {
+		r.columns[i] = -1
+
+		for j, item := range r.fields {
+			if item.Name == name {
+				r.columns[i] = j
+
+				break
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+package nullcsv
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Patrick-Batenburg/nullify/null"
+)
+
+type Audit struct {
+	CreatedAt null.Time `csv:"created_at"`
+}
+
+type Partner struct {
+	ID      int           `csv:"id"`
+	Name    null.String   `csv:"name"`
+	Age     null.Int8     `csv:"age"`
+	Score   *null.Float64 `csv:"score"`
+	Active  null.Bool     `csv:"active"`
+	Note    *string       `csv:"note"`
+	Ignored string        `csv:"-"`
+	Audit
+}
+
+func TestReaderRead(t *testing.T) {
+	input := "name,id,age,score,active,note,created_at,unknown\n" +
+		"John,1,42,1.5,true,hi,2024-01-02T03:04:05Z,x\n" +
+		`\N,2,\N,\N,\N,\N,\N,y` + "\n" +
+		",3,,,,,,z\n"
+
+	reader := NewReader[Partner](strings.NewReader(input), WithNullToken(`\N`))
+	values, err := reader.ReadAll()
+	require.ErrorIs(t, err, null.ErrCannotUnmarshal)
+	require.Len(t, values, 2)
+
+	assert.Equal(
+		t,
+		[]string{"name", "id", "age", "score", "active", "note", "created_at", "unknown"},
+		reader.Header(),
+	)
+
+	assert.Equal(t, 1, values[0].ID)
+	assert.Equal(t, null.StringFrom("John"), values[0].Name)
+	assert.Equal(t, null.Int8From(42), values[0].Age)
+	assert.Equal(t, null.Float64From(1.5), *values[0].Score)
+	assert.Equal(t, null.BoolFrom(true), values[0].Active)
+	assert.Equal(t, "hi", *values[0].Note)
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	assert.True(t, values[0].CreatedAt.ValueOrZero().Equal(createdAt))
+
+	assert.Equal(t, Partner{ID: 2}, values[1])
+
+	var fieldError FieldError
+	require.ErrorAs(t, err, &fieldError)
+	assert.Equal(t, 4, fieldError.Line)
+	assert.Equal(t, 3, fieldError.Column)
+	assert.Equal(t, "age", fieldError.Field)
+}
+
+func TestReaderEmptyIsNotNull(t *testing.T) {
+	type row struct {
+		Name null.String `csv:"name"`
+		Nick null.String `csv:"nick"`
+		Raw  null.Bytes  `csv:"raw"`
+	}
+
+	input := "name,nick,raw\n,null,\nNULL,NULL,NULL\n"
+	reader := NewReader[row](strings.NewReader(input), WithNullToken("NULL"))
+	values, err := reader.ReadAll()
+	require.NoError(t, err)
+	require.Len(t, values, 2)
+
+	assert.Equal(t, null.StringFrom(""), values[0].Name)
+	assert.Equal(t, null.StringFrom("null"), values[0].Nick)
+	assert.Equal(t, null.BytesFrom([]byte{}), values[0].Raw)
+	assert.Equal(t, row{}, values[1])
+}
+
+func TestReaderWithConfig(t *testing.T) {
+	config := null.DefaultConfig()
+	config.TrueText = "yes"
+	config.FalseText = "no"
+	config.EmptyAsNull = true
+
+	input := "name,active\n,yes\nJohn,no\n"
+	reader := NewReader[Partner](strings.NewReader(input), WithConfig(config))
+	values, err := reader.ReadAll()
+	require.NoError(t, err)
+	require.Len(t, values, 2)
+
+	assert.False(t, values[0].Name.IsValid())
+	assert.True(t, values[0].Active.ValueOrZero())
+	assert.Equal(t, "John", values[1].Name.ValueOrZero())
+	assert.Equal(t, "no", values[1].Active.String())
+}
+
+func TestReaderWithoutHeader(t *testing.T) {
+	type row struct {
+		Name null.String
+		Age  null.Int
+	}
+
+	reader := NewReader[row](
+		strings.NewReader("John;-\n-;42\n"),
+		WithoutHeader(),
+		WithComma(';'),
+		WithNullToken("-"),
+	)
+
+	value, err := reader.Read()
+	require.NoError(t, err)
+	assert.Equal(t, row{Name: null.StringFrom("John")}, value)
+
+	value, err = reader.Read()
+	require.NoError(t, err)
+	assert.Equal(t, row{Age: null.IntFrom(42)}, value)
+
+	_, err = reader.Read()
+	require.ErrorIs(t, err, io.EOF)
+	assert.Nil(t, reader.Header())
+}
+
+func TestReaderAllContinuesAfterFieldError(t *testing.T) {
+	type row struct {
+		Age null.Int `csv:"age"`
+	}
+
+	reader := NewReader[row](strings.NewReader("age\n1\nx\n3\n"))
+
+	var ages []int
+	var errs []error
+
+	for value, err := range reader.All() {
+		if err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		ages = append(ages, value.Age.ValueOrZero())
+	}
+
+	assert.Equal(t, []int{1, 3}, ages)
+	require.Len(t, errs, 1)
+
+	var fieldError FieldError
+	require.ErrorAs(t, errs[0], &fieldError)
+	assert.Equal(t, 3, fieldError.Line)
+	assert.Equal(t, 1, fieldError.Column)
+
+	var unmarshalError null.UnmarshalError
+	assert.ErrorAs(t, errs[0], &unmarshalError)
+}
+
+func TestReaderErrors(t *testing.T) {
+	_, err := NewReader[int](strings.NewReader("1\n")).Read()
+	require.ErrorIs(t, err, ErrNotStruct)
+
+	type row struct {
+		Values []int `csv:"values"`
+	}
+
+	_, err = NewReader[row](strings.NewReader("values\n1\n")).Read()
+	require.ErrorIs(t, err, ErrUnsupportedType)
+	require.ErrorContains(t, err, "nullcsv: unsupported field type")
+	require.ErrorIs(t, err, null.ErrCannotUnmarshal)
+
+	_, err = NewReader[Partner](strings.NewReader("name\n\"x\n")).Read()
+	var parseError *csv.ParseError
+	require.ErrorAs(t, err, &parseError)
+}
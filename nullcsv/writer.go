@@ -0,0 +1,123 @@
+package nullcsv // import "github.com/Patrick-Batenburg/nullify/nullcsv"
+
+import (
+	"encoding/csv"
+	"io"
+	"reflect"
+
+	"github.com/Patrick-Batenburg/nullify/internal/structfield"
+)
+
+// Writer encodes values of the struct type T as CSV records.
+// Cells are encoded through encoding.TextMarshaler, which all null types implement.
+type Writer[T any] struct {
+	writer  *csv.Writer
+	options options
+	fields  []structfield.Field
+	record  []string
+	line    int
+	err     error
+	started bool
+}
+
+// NewWriter returns a Writer that writes records to w.
+func NewWriter[T any](w io.Writer, opts ...OptionFn) *Writer[T] {
+	option := newOptions(opts...)
+	writer := csv.NewWriter(w)
+	writer.Comma = option.comma
+
+	return &Writer[T]{
+		writer:  writer,
+		options: option,
+	}
+}
+
+// Write encodes value as a record. The header record is written before the first record.
+// Writes are buffered, so Flush must be called to ensure that the record is written.
+// Encoding errors are returned as a FieldError, in which case nothing is written.
+func (w *Writer[T]) Write(value T) error {
+	if err := w.start(); err != nil {
+		return err
+	}
+
+	source := reflect.ValueOf(value)
+
+	for i, item := range w.fields {
+		cell, err := encodeCell(source.FieldByIndex(item.Index), w.options.nullToken)
+
+		if err != nil {
+			return FieldError{
+				Line:   w.line + 1,
+				Column: i + 1,
+				Field:  item.Name,
+				err:    err,
+			}
+		}
+
+		w.record[i] = cell
+	}
+
+	return w.writeRecord(w.record)
+}
+
+// WriteAll encodes all values and flushes the Writer.
+// The header record is written even if values is empty.
+func (w *Writer[T]) WriteAll(values []T) error {
+	if err := w.start(); err != nil {
+		return err
+	}
+
+	for _, value := range values {
+		if err := w.Write(value); err != nil {
+			return err
+		}
+	}
+
+	return w.Flush()
+}
+
+// Flush writes any buffered data to the underlying io.Writer and returns any error that occurred.
+func (w *Writer[T]) Flush() error {
+	w.writer.Flush()
+
+	return w.writer.Error()
+}
+
+// start resolves the columns of T and writes the header record on the first call.
+func (w *Writer[T]) start() error {
+	if w.started {
+		return w.err
+	}
+
+	w.started = true
+	w.fields, w.err = structFields(reflect.TypeFor[T]())
+
+	if w.err != nil {
+		return w.err
+	}
+
+	w.record = make([]string, len(w.fields))
+
+	if !w.options.header {
+		return nil
+	}
+
+	for i, item := range w.fields {
+		w.record[i] = item.Name
+	}
+
+	w.err = w.writeRecord(w.record)
+
+	return w.err
+}
+
+// writeRecord writes a single record.
+func (w *Writer[T]) writeRecord(record []string) error {
+	if err := w.writer.Write(record); err != nil {
+		return err
+	}
+
+	w.line++
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package nullcsv
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Patrick-Batenburg/nullify/null"
+)
+
+func TestWriterWrite(t *testing.T) {
+	score := null.Float64From(1.5)
+	note := "hi"
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	var buffer bytes.Buffer
+	writer := NewWriter[Partner](&buffer, WithNullToken("NULL"))
+	err := writer.WriteAll([]Partner{
+		{
+			ID:     1,
+			Name:   null.StringFrom("John"),
+			Age:    null.Int8From(42),
+			Score:  &score,
+			Active: null.BoolFrom(false),
+			Note:   &note,
+			Audit:  Audit{CreatedAt: null.TimeFrom(createdAt, null.WithTimeLayout(time.DateOnly))},
+		},
+		{
+			ID:   2,
+			Name: null.StringFrom(""),
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(
+		t,
+		"id,name,age,score,active,note,created_at\n"+
+			"1,John,42,1.5,false,hi,2024-01-02\n"+
+			"2,,NULL,NULL,NULL,NULL,NULL\n",
+		buffer.String(),
+	)
+}
+
+func TestWriterRoundTrip(t *testing.T) {
+	type row struct {
+		Name null.String `csv:"name"`
+		Age  null.Uint16 `csv:"age"`
+		ID   null.UUID   `csv:"id"`
+	}
+
+	values := []row{
+		{
+			Name: null.StringFrom(""),
+			Age:  null.Uint16From(7),
+			ID:   null.UUIDFrom(uuid.MustParse("01000000-0000-0000-0000-000000000000")),
+		},
+		{Name: null.StringFrom("null")},
+	}
+
+	var buffer bytes.Buffer
+	err := NewWriter[row](&buffer, WithNullToken(`\N`), WithComma('\t')).WriteAll(values)
+	require.NoError(t, err)
+
+	decoded, err := NewReader[row](&buffer, WithNullToken(`\N`), WithComma('\t')).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, values, decoded)
+}
+
+func TestWriterWithoutHeader(t *testing.T) {
+	type row struct {
+		Name null.String
+	}
+
+	var buffer bytes.Buffer
+	writer := NewWriter[row](&buffer, WithoutHeader())
+	require.NoError(t, writer.Write(row{Name: null.StringFrom("John")}))
+	require.NoError(t, writer.Write(row{}))
+	require.NoError(t, writer.Flush())
+	assert.Equal(t, "John\n\n", buffer.String())
+}
+
+func TestWriterErrors(t *testing.T) {
+	var buffer bytes.Buffer
+	err := NewWriter[string](&buffer).Write("x")
+	require.ErrorIs(t, err, ErrNotStruct)
+
+	type row struct {
+		Name   string         `csv:"name"`
+		Values map[string]int `csv:"values"`
+	}
+
+	err = NewWriter[row](&buffer).Write(row{})
+
+	var fieldError FieldError
+	require.ErrorAs(t, err, &fieldError)
+	require.ErrorIs(t, err, ErrUnsupportedType)
+	require.ErrorIs(t, err, null.ErrCannotMarshal)
+	assert.Equal(t, 2, fieldError.Line)
+	assert.Equal(t, 2, fieldError.Column)
+	assert.Equal(t, "values", fieldError.Field)
+}
@@ -0,0 +1,70 @@
+package nullenv // import "github.com/Patrick-Batenburg/nullify/nullenv"
+
+import (
+	"reflect"
+
+	"github.com/Patrick-Batenburg/nullify/internal/structfield"
+)
+
+// redacted replaces the value of secret variables in Dump.
+const redacted = "******"
+
+// Variable is a single entry of the effective configuration returned by Dump.
+type Variable struct {
+	// Name is the name of the variable, including the prefix.
+	Name string
+
+	// Value is the value of the field encoded as text, or empty if invalid.
+	// The value of fields tagged with `env:"NAME,secret"` is redacted.
+	Value string
+
+	// Valid reports whether the field holds a value.
+	Valid bool
+}
+
+// String returns the variable in the NAME=value format, or NAME= if invalid.
+func (v Variable) String() string {
+	return v.Name + "=" + v.Value
+}
+
+// Dump lists the effective configuration held by the struct, or pointer to a struct, src,
+// in the order of its env tagged fields.
+func Dump(src any, opts ...OptionFn) ([]Variable, error) {
+	source := reflect.ValueOf(src)
+
+	if source.Kind() == reflect.Pointer && !source.IsNil() {
+		source = source.Elem()
+	}
+
+	if source.Kind() != reflect.Struct {
+		return nil, ErrNotStruct
+	}
+
+	option := newOptions(opts...)
+	variables := make([]Variable, 0, source.NumField())
+
+	for _, field := range fields(source.Type()) {
+		name := option.prefix + field.Name
+		value := source.FieldByIndex(field.Index)
+		text, valid, err := structfield.EncodeText(value, ErrUnsupportedType)
+
+		if err != nil {
+			return nil, FieldError{
+				Name: name,
+				err:  err,
+			}
+		}
+
+		if valid && field.HasOption(secretOption) {
+			text = redacted
+		}
+
+		variables = append(variables, Variable{
+			Name:  name,
+			Value: text,
+			Valid: valid,
+		})
+	}
+
+	return variables, nil
+}
@@ -0,0 +1,39 @@
+package nullenv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Patrick-Batenburg/nullify/null"
+)
+
+func TestDump(t *testing.T) {
+	config := Config{
+		Port: null.Uint16From(8080),
+		Name: "service",
+		Database: Database{
+			Host:     null.StringFrom("localhost"),
+			Password: null.StringFrom("hunter2"),
+		},
+	}
+
+	variables, err := Dump(&config, WithPrefix("APP_"))
+	require.NoError(t, err)
+	assert.Equal(t, []Variable{
+		{Name: "APP_PORT", Value: "8080", Valid: true},
+		{Name: "APP_DEBUG"},
+		{Name: "APP_STARTED_AT"},
+		{Name: "APP_ID"},
+		{Name: "APP_TIMEOUT"},
+		{Name: "APP_NAME", Value: "service", Valid: true},
+		{Name: "APP_DB_HOST", Value: "localhost", Valid: true},
+		{Name: "APP_DB_PASSWORD", Value: "******", Valid: true},
+	}, variables)
+	assert.Equal(t, "APP_PORT=8080", variables[0].String())
+	assert.Equal(t, "APP_DEBUG=", variables[1].String())
+
+	_, err = Dump(1)
+	require.ErrorIs(t, err, ErrNotStruct)
+}
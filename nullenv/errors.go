@@ -0,0 +1,51 @@
+package nullenv // import "github.com/Patrick-Batenburg/nullify/nullenv"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Patrick-Batenburg/nullify/internal/structfield"
+)
+
+var (
+	ErrInvalidDestination = errors.New("nullenv: destination must be a non-nil pointer to a struct")
+	ErrNotStruct          = errors.New("nullenv: source must be a struct or a pointer to a struct")
+	ErrRequired           = errors.New("nullenv: required variable is not set")
+	ErrUnsupportedType    = fmt.Errorf("nullenv: %w", structfield.ErrUnsupportedType)
+)
+
+// FieldError represents an error that occurs while parsing a single variable.
+// It wraps a null.UnmarshalError.
+type FieldError struct {
+	// Name is the name of the variable, including the prefix.
+	Name string
+
+	err error
+}
+
+// Error returns the string representation of the FieldError.
+func (e FieldError) Error() string {
+	return fmt.Sprintf("nullenv: variable %s: %v", e.Name, e.err)
+}
+
+// Unwrap returns the underlying error for unwrapping.
+func (e FieldError) Unwrap() error {
+	return e.err
+}
+
+// RequiredError lists every required variable that is not set or empty.
+type RequiredError struct {
+	// Names are the names of the missing variables, including the prefix.
+	Names []string
+}
+
+// Error returns the string representation of the RequiredError.
+func (e RequiredError) Error() string {
+	return fmt.Sprintf("%v: %s", ErrRequired, strings.Join(e.Names, ", "))
+}
+
+// Unwrap returns ErrRequired.
+func (e RequiredError) Unwrap() error {
+	return ErrRequired
+}
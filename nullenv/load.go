@@ -0,0 +1,109 @@
+package nullenv // import "github.com/Patrick-Batenburg/nullify/nullenv"
+
+import (
+	"errors"
+	"reflect"
+
+	"github.com/Patrick-Batenburg/nullify/internal/structfield"
+)
+
+const (
+	// tagName is the struct tag used to name the variable of a field.
+	tagName = "env"
+
+	// defaultTagName is the struct tag holding the default value of a field.
+	defaultTagName = "default"
+
+	// requiredOption marks a variable as required in the env tag.
+	requiredOption = "required"
+
+	// secretOption hides the value of a variable in Dump in the env tag.
+	secretOption = "secret"
+)
+
+// Load fills the struct pointed to by dest from environment variables.
+// Only fields with an env tag are loaded, such as `env:"PORT"`, and untagged embedded structs are flattened.
+// Variables are parsed through encoding.TextUnmarshaler, which all null types implement, so a null.Time
+// is parsed leniently and a null.UUID is validated.
+//
+// Variables that are not set or empty use the value of the default tag, such as `default:"8080"`,
+// and are null otherwise. Fields tagged with `env:"NAME,required"` must be set to a non-empty value
+// or have a default value.
+// All parse errors and a RequiredError listing every missing variable are returned joined together.
+func Load(dest any, opts ...OptionFn) error {
+	target := reflect.ValueOf(dest)
+
+	if target.Kind() != reflect.Pointer ||
+		target.IsNil() ||
+		target.Elem().Kind() != reflect.Struct {
+		return ErrInvalidDestination
+	}
+
+	option := newOptions(opts...)
+	target = target.Elem()
+
+	if option.config != nil {
+		if err := option.config.Apply(dest); err != nil {
+			return err
+		}
+	}
+
+	var (
+		errs    []error
+		missing []string
+	)
+
+	for _, field := range fields(target.Type()) {
+		name := option.prefix + field.Name
+		text, ok := option.lookup(name)
+
+		if !ok || text == "" {
+			text, ok = field.Tag.Lookup(defaultTagName)
+		}
+
+		valid := ok && text != ""
+
+		if !valid && field.HasOption(requiredOption) {
+			missing = append(missing, name)
+
+			continue
+		}
+
+		if err := load(target.FieldByIndex(field.Index), text, valid); err != nil {
+			errs = append(errs, FieldError{
+				Name: name,
+				err:  err,
+			})
+		}
+	}
+
+	if len(missing) > 0 {
+		errs = append(errs, RequiredError{
+			Names: missing,
+		})
+	}
+
+	return errors.Join(errs...)
+}
+
+// load sets value to the parsed text, or to null if valid is false.
+func load(value reflect.Value, text string, valid bool) error {
+	if !valid {
+		return structfield.SetNull(value)
+	}
+
+	return structfield.DecodeText(value, text, ErrUnsupportedType)
+}
+
+// fields returns the fields of the struct type t that have an env tag.
+func fields(t reflect.Type) []structfield.Field {
+	var result []structfield.Field
+
+	for _, field := range structfield.Fields(t, tagName) {
+		if field.Tagged {
+			result = append(result, field)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,138 @@
+package nullenv
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Patrick-Batenburg/nullify/null"
+)
+
+type Database struct {
+	Host     null.String `env:"DB_HOST,required"`
+	Password null.String `env:"DB_PASSWORD,secret"`
+}
+
+type Config struct {
+	Port      null.Uint16 `env:"PORT"      default:"8080"`
+	Debug     null.Bool   `env:"DEBUG"`
+	StartedAt null.Time   `env:"STARTED_AT"`
+	ID        null.UUID   `env:"ID"`
+	Timeout   *null.Int64 `env:"TIMEOUT"`
+	Name      string      `env:"NAME"`
+	Untagged  null.String
+	Database
+}
+
+func TestLoad(t *testing.T) {
+	id := uuid.New()
+
+	var config Config
+	err := Load(&config, WithPrefix("APP_"), WithMap(map[string]string{
+		"APP_DEBUG":      "true",
+		"APP_STARTED_AT": "2024-01-02 03:04:05",
+		"APP_ID":         id.String(),
+		"APP_TIMEOUT":    "30",
+		"APP_NAME":       "service",
+		"APP_DB_HOST":    "localhost",
+		"Untagged":       "ignored",
+	}))
+	require.NoError(t, err)
+
+	assert.Equal(t, null.Uint16From(8080), config.Port)
+	assert.Equal(t, null.BoolFrom(true), config.Debug)
+	startedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	assert.True(t, config.StartedAt.ValueOrZero().Equal(startedAt))
+	assert.Equal(t, null.UUIDFrom(id), config.ID)
+	assert.Equal(t, null.Int64From(30), *config.Timeout)
+	assert.Equal(t, "service", config.Name)
+	assert.False(t, config.Untagged.IsValid())
+	assert.Equal(t, null.StringFrom("localhost"), config.Host)
+	assert.False(t, config.Password.IsValid())
+}
+
+func TestLoadUnsetIsNull(t *testing.T) {
+	config := Config{
+		Debug: null.BoolFrom(true),
+		Port:  null.Uint16From(1),
+	}
+
+	err := Load(&config, WithMap(map[string]string{
+		"PORT":    "",
+		"DEBUG":   "",
+		"DB_HOST": "localhost",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, null.Uint16From(8080), config.Port)
+	assert.False(t, config.Debug.IsValid())
+	assert.Nil(t, config.Timeout)
+}
+
+func TestLoadWithConfig(t *testing.T) {
+	config := null.DefaultConfig()
+	config.PreferMonthFirst = false
+
+	var loaded Config
+	err := Load(&loaded, WithConfig(config), WithMap(map[string]string{
+		"STARTED_AT": "02.03.2024",
+		"DB_HOST":    "localhost",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, time.March, loaded.StartedAt.ValueOrZero().Month())
+}
+
+func TestLoadErrors(t *testing.T) {
+	type required struct {
+		A null.String `env:"A,required"`
+		B null.Int    `env:"B,required"`
+		C null.Int    `env:"C,required" default:"3"`
+	}
+
+	var value required
+	err := Load(&value, WithPrefix("X_"), WithMap(map[string]string{"X_A": ""}))
+	require.ErrorIs(t, err, ErrRequired)
+
+	var requiredError RequiredError
+	require.ErrorAs(t, err, &requiredError)
+	assert.Equal(t, []string{"X_A", "X_B"}, requiredError.Names)
+	assert.Equal(t, null.IntFrom(3), value.C)
+
+	var config Config
+	err = Load(&config, WithMap(map[string]string{
+		"PORT":    "70000",
+		"ID":      "not-a-uuid",
+		"DB_HOST": "localhost",
+	}))
+	require.ErrorIs(t, err, null.ErrCannotUnmarshal)
+	assert.NotErrorIs(t, err, ErrRequired)
+
+	var fieldError FieldError
+	require.ErrorAs(t, err, &fieldError)
+	assert.Equal(t, "PORT", fieldError.Name)
+	assert.Contains(t, err.Error(), "nullenv: variable ID:")
+
+	err = Load(config)
+	require.ErrorIs(t, err, ErrInvalidDestination)
+
+	var unsupported struct {
+		Values map[string]int `env:"VALUES"`
+	}
+
+	err = Load(&unsupported, WithMap(map[string]string{"VALUES": "1"}))
+	require.ErrorIs(t, err, ErrUnsupportedType)
+	require.ErrorContains(t, err, "nullenv: unsupported field type")
+}
+
+func TestLoadEnvironment(t *testing.T) {
+	t.Setenv("NULLENV_TEST_DB_HOST", "db")
+	t.Setenv("NULLENV_TEST_DEBUG", "false")
+
+	var config Config
+	err := Load(&config, WithPrefix("NULLENV_TEST_"))
+	require.NoError(t, err)
+	assert.Equal(t, null.StringFrom("db"), config.Host)
+	assert.Equal(t, null.BoolFrom(false), config.Debug)
+}
@@ -0,0 +1,60 @@
+package nullenv // import "github.com/Patrick-Batenburg/nullify/nullenv"
+
+import (
+	"os"
+
+	"github.com/Patrick-Batenburg/nullify/null"
+)
+
+// options holds the configuration of Load and Dump.
+type options struct {
+	prefix string
+	lookup func(string) (string, bool)
+	config *null.Config
+}
+
+// OptionFn is a type alias for a function that modifies the options of Load and Dump.
+type OptionFn = func(*options)
+
+// newOptions returns the default options with opts applied.
+func newOptions(opts ...OptionFn) options {
+	option := options{
+		lookup: os.LookupEnv,
+	}
+
+	for _, opt := range opts {
+		opt(&option)
+	}
+
+	return option
+}
+
+// WithPrefix prepends prefix to the name of every variable, such as "APP_" for "APP_PORT".
+func WithPrefix(prefix string) OptionFn {
+	return func(option *options) {
+		option.prefix = prefix
+	}
+}
+
+// WithLookup sets the function used to look up variables. The default function is os.LookupEnv.
+func WithLookup(lookup func(string) (string, bool)) OptionFn {
+	return func(option *options) {
+		option.lookup = lookup
+	}
+}
+
+// WithMap looks up variables in values instead of the environment.
+func WithMap(values map[string]string) OptionFn {
+	return WithLookup(func(name string) (string, bool) {
+		value, ok := values[name]
+
+		return value, ok
+	})
+}
+
+// WithConfig binds config to the nullable fields of the destination before loading. It is ignored by Dump, see null.Config.Apply.
+func WithConfig(config null.Config) OptionFn {
+	return func(option *options) {
+		option.config = &config
+	}
+}
@@ -0,0 +1,122 @@
+package nullform // import "github.com/Patrick-Batenburg/nullify/nullform"
+
+import (
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"reflect"
+
+	"github.com/Patrick-Batenburg/nullify/internal/structfield"
+)
+
+// tagName is the struct tag used to name the form key of a field.
+const tagName = "form"
+
+// defaultMaxMemory is the maximum number of bytes of a multipart form stored in memory,
+// matching the default of http.Request.FormValue.
+const defaultMaxMemory = 32 << 20
+
+// Decode decodes values into the struct pointed to by dest.
+// Fields are named by their form tag or, if untagged, by their field name, and
+// decoded through encoding.TextUnmarshaler, which all null types implement.
+// Repeated keys are decoded into slice fields, in which empty values are always null.
+// Whether empty values and missing keys are null is determined by the NullPolicy.
+// All field errors are returned joined together as FieldError values.
+func Decode(values url.Values, dest any, opts ...OptionFn) error {
+	target := reflect.ValueOf(dest)
+
+	if target.Kind() != reflect.Pointer ||
+		target.IsNil() ||
+		target.Elem().Kind() != reflect.Struct {
+		return ErrInvalidDestination
+	}
+
+	option := newOptions(opts...)
+	target = target.Elem()
+
+	if option.config != nil {
+		if err := option.config.Apply(dest); err != nil {
+			return err
+		}
+	}
+
+	var errs []error
+
+	for _, field := range structfield.Fields(target.Type(), tagName) {
+		err := decodeField(target.FieldByIndex(field.Index), values[field.Name], option)
+
+		if err != nil {
+			errs = append(errs, FieldError{
+				Field: field.Name,
+				err:   err,
+			})
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// DecodeMultipart decodes the values of a multipart form into the struct pointed to by dest.
+// Files are ignored.
+func DecodeMultipart(form *multipart.Form, dest any, opts ...OptionFn) error {
+	return Decode(form.Value, dest, opts...)
+}
+
+// DecodeRequest parses the query string and the url-encoded or multipart body of r and
+// decodes them into the struct pointed to by dest. Body values take precedence over the query string.
+func DecodeRequest(r *http.Request, dest any, opts ...OptionFn) error {
+	err := r.ParseMultipartForm(defaultMaxMemory)
+
+	if err != nil && !errors.Is(err, http.ErrNotMultipart) {
+		return err
+	}
+
+	return Decode(r.Form, dest, opts...)
+}
+
+// decodeField decodes the values of a single key into value.
+// A nil values slice means the key is missing.
+func decodeField(value reflect.Value, values []string, option options) error {
+	if values == nil {
+		if option.missingIsNull() {
+			return structfield.SetNull(value)
+		}
+
+		return nil
+	}
+
+	if isSlice(value.Type()) {
+		slice := reflect.MakeSlice(value.Type(), len(values), len(values))
+
+		for i, item := range values {
+			var err error
+
+			if item == "" {
+				err = structfield.SetNull(slice.Index(i))
+			} else {
+				err = structfield.DecodeText(slice.Index(i), item, ErrUnsupportedType)
+			}
+
+			if err != nil {
+				return err
+			}
+		}
+
+		value.Set(slice)
+
+		return nil
+	}
+
+	if len(values) == 0 || (values[0] == "" && option.emptyIsNull()) {
+		return structfield.SetNull(value)
+	}
+
+	return structfield.DecodeText(value, values[0], ErrUnsupportedType)
+}
+
+// isSlice reports whether t is a slice that holds the values of a repeated key.
+// Byte slices are not, as they are decoded from a single value.
+func isSlice(t reflect.Type) bool {
+	return t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8
+}
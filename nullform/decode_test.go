@@ -0,0 +1,172 @@
+package nullform
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Patrick-Batenburg/nullify/null"
+)
+
+type Filter struct {
+	Name    null.String         `form:"name"`
+	Age     null.Int            `form:"age"`
+	Active  null.Bool           `form:"active"`
+	Since   null.Time           `form:"since"`
+	Tags    []null.String       `form:"tag"`
+	IDs     []null.Int64        `form:"id"`
+	Page    int                 `form:"page"`
+	Limit   *null.Uint16        `form:"limit"`
+	Comment null.OptionalString `form:"comment"`
+	Ignored string              `form:"-"`
+}
+
+func TestDecode(t *testing.T) {
+	values := url.Values{
+		"name":   {"John"},
+		"age":    {"42"},
+		"active": {"true"},
+		"since":  {"2024-01-02T03:04:05Z"},
+		"tag":    {"a", "", "b"},
+		"id":     {"1", "2"},
+		"page":   {"3"},
+		"limit":  {"10"},
+	}
+
+	var filter Filter
+	err := Decode(values, &filter)
+	require.NoError(t, err)
+
+	assert.Equal(t, null.StringFrom("John"), filter.Name)
+	assert.Equal(t, null.IntFrom(42), filter.Age)
+	assert.Equal(t, null.BoolFrom(true), filter.Active)
+	since := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	assert.True(t, filter.Since.ValueOrZero().Equal(since))
+	assert.Equal(t, []null.String{null.StringFrom("a"), {}, null.StringFrom("b")}, filter.Tags)
+	assert.Equal(t, []null.Int64{null.Int64From(1), null.Int64From(2)}, filter.IDs)
+	assert.Equal(t, 3, filter.Page)
+	assert.Equal(t, null.Uint16From(10), *filter.Limit)
+	assert.False(t, filter.Comment.IsSet())
+}
+
+func TestDecodeWithConfig(t *testing.T) {
+	config := null.DefaultConfig()
+	config.TrueText = "on"
+	config.FalseText = "off"
+
+	var filter Filter
+	err := Decode(url.Values{"active": {"on"}}, &filter, WithConfig(config))
+	require.NoError(t, err)
+	assert.True(t, filter.Active.ValueOrZero())
+
+	var unconfigured Filter
+	err = Decode(url.Values{"active": {"on"}}, &unconfigured)
+	require.ErrorIs(t, err, null.ErrCannotUnmarshal)
+}
+
+func TestDecodeNullPolicy(t *testing.T) {
+	values := url.Values{
+		"name":    {""},
+		"comment": {""},
+	}
+
+	prefilled := Filter{
+		Age:  null.IntFrom(1),
+		Tags: []null.String{null.StringFrom("a")},
+	}
+
+	filter := prefilled
+	err := Decode(values, &filter)
+	require.NoError(t, err)
+	assert.False(t, filter.Name.IsValid())
+	assert.True(t, filter.Comment.IsNull())
+	assert.Equal(t, prefilled.Age, filter.Age)
+	assert.Equal(t, prefilled.Tags, filter.Tags)
+
+	filter = prefilled
+	err = Decode(values, &filter, WithNullPolicy(NullIfMissing))
+	require.NoError(t, err)
+	assert.Equal(t, null.StringFrom(""), filter.Name)
+	assert.True(t, filter.Comment.IsValue())
+	assert.False(t, filter.Age.IsValid())
+	assert.Nil(t, filter.Tags)
+
+	filter = prefilled
+	err = Decode(values, &filter, WithNullPolicy(NullIfEmptyOrMissing))
+	require.NoError(t, err)
+	assert.False(t, filter.Name.IsValid())
+	assert.True(t, filter.Comment.IsNull())
+	assert.False(t, filter.Age.IsValid())
+	assert.Nil(t, filter.Tags)
+}
+
+func TestDecodeErrors(t *testing.T) {
+	var filter Filter
+	err := Decode(url.Values{"age": {"x"}, "id": {"1", "y"}, "page": {"z"}}, &filter)
+	require.ErrorIs(t, err, null.ErrCannotUnmarshal)
+
+	var fieldError FieldError
+	require.ErrorAs(t, err, &fieldError)
+	assert.Equal(t, "age", fieldError.Field)
+	assert.Contains(t, err.Error(), "nullform: field id:")
+	assert.Contains(t, err.Error(), "nullform: field page:")
+
+	err = Decode(url.Values{}, filter)
+	require.ErrorIs(t, err, ErrInvalidDestination)
+
+	err = Decode(url.Values{}, (*Filter)(nil))
+	require.ErrorIs(t, err, ErrInvalidDestination)
+
+	type unsupported struct {
+		Values map[string]int `form:"values"`
+	}
+
+	err = Decode(url.Values{"values": {"1"}}, &unsupported{})
+	require.ErrorIs(t, err, ErrUnsupportedType)
+	require.ErrorContains(t, err, "nullform: unsupported field type")
+}
+
+func TestDecodeRequest(t *testing.T) {
+	body := url.Values{"name": {"John"}, "tag": {"a", "b"}}.Encode()
+	request := httptest.NewRequest(http.MethodPost, "/?age=42&name=Jane", strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var filter Filter
+	err := DecodeRequest(request, &filter)
+	require.NoError(t, err)
+	assert.Equal(t, null.StringFrom("John"), filter.Name)
+	assert.Equal(t, null.IntFrom(42), filter.Age)
+	assert.Equal(t, []null.String{null.StringFrom("a"), null.StringFrom("b")}, filter.Tags)
+}
+
+func TestDecodeMultipart(t *testing.T) {
+	var buffer bytes.Buffer
+	writer := multipart.NewWriter(&buffer)
+	require.NoError(t, writer.WriteField("name", "John"))
+	require.NoError(t, writer.WriteField("id", "7"))
+	require.NoError(t, writer.WriteField("active", ""))
+	require.NoError(t, writer.Close())
+
+	request := httptest.NewRequest(http.MethodPost, "/", &buffer)
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+
+	var filter Filter
+	err := DecodeRequest(request, &filter)
+	require.NoError(t, err)
+	assert.Equal(t, null.StringFrom("John"), filter.Name)
+	assert.Equal(t, []null.Int64{null.Int64From(7)}, filter.IDs)
+	assert.False(t, filter.Active.IsValid())
+
+	filter = Filter{}
+	err = DecodeMultipart(request.MultipartForm, &filter)
+	require.NoError(t, err)
+	assert.Equal(t, null.StringFrom("John"), filter.Name)
+}
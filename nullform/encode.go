@@ -0,0 +1,86 @@
+package nullform // import "github.com/Patrick-Batenburg/nullify/nullform"
+
+import (
+	"errors"
+	"net/url"
+	"reflect"
+
+	"github.com/Patrick-Batenburg/nullify/internal/structfield"
+)
+
+// Encode encodes the struct, or pointer to a struct, src into url.Values.
+// Fields are encoded through encoding.TextMarshaler, which all null types implement, and slice fields
+// are encoded as repeated keys, in which invalid values are always empty.
+// Invalid fields are encoded as empty values with NullIfEmpty and omitted with the other policies.
+// All field errors are returned joined together as FieldError values.
+func Encode(src any, opts ...OptionFn) (url.Values, error) {
+	source := reflect.ValueOf(src)
+
+	if source.Kind() == reflect.Pointer && !source.IsNil() {
+		source = source.Elem()
+	}
+
+	if source.Kind() != reflect.Struct {
+		return nil, ErrNotStruct
+	}
+
+	option := newOptions(opts...)
+	values := url.Values{}
+
+	var errs []error
+
+	for _, field := range structfield.Fields(source.Type(), tagName) {
+		items, err := encodeField(source.FieldByIndex(field.Index), option)
+
+		if err != nil {
+			errs = append(errs, FieldError{
+				Field: field.Name,
+				err:   err,
+			})
+
+			continue
+		}
+
+		if items != nil {
+			values[field.Name] = items
+		}
+	}
+
+	return values, errors.Join(errs...)
+}
+
+// encodeField encodes value into the values of a single key.
+// A nil result means the key is omitted.
+func encodeField(value reflect.Value, option options) ([]string, error) {
+	if isSlice(value.Type()) {
+		if value.Len() == 0 {
+			return nil, nil
+		}
+
+		items := make([]string, value.Len())
+
+		for i := range items {
+			text, _, err := structfield.EncodeText(value.Index(i), ErrUnsupportedType)
+
+			if err != nil {
+				return nil, err
+			}
+
+			items[i] = text
+		}
+
+		return items, nil
+	}
+
+	text, valid, err := structfield.EncodeText(value, ErrUnsupportedType)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !valid && option.missingIsNull() {
+		return nil, nil
+	}
+
+	return []string{text}, nil
+}
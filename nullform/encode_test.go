@@ -0,0 +1,89 @@
+package nullform
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Patrick-Batenburg/nullify/null"
+)
+
+func TestEncode(t *testing.T) {
+	limit := null.Uint16From(10)
+	filter := Filter{
+		Name:  null.StringFrom("John"),
+		Tags:  []null.String{null.StringFrom("a"), {}, null.StringFrom("b")},
+		Page:  3,
+		Limit: &limit,
+	}
+
+	values, err := Encode(filter)
+	require.NoError(t, err)
+	assert.Equal(t, url.Values{
+		"name":    {"John"},
+		"age":     {""},
+		"active":  {""},
+		"since":   {""},
+		"tag":     {"a", "", "b"},
+		"page":    {"3"},
+		"limit":   {"10"},
+		"comment": {""},
+	}, values)
+
+	values, err = Encode(&filter, WithNullPolicy(NullIfMissing))
+	require.NoError(t, err)
+	assert.Equal(t, url.Values{
+		"name":  {"John"},
+		"tag":   {"a", "", "b"},
+		"page":  {"3"},
+		"limit": {"10"},
+	}, values)
+}
+
+func TestEncodeRoundTrip(t *testing.T) {
+	filter := Filter{
+		Name: null.StringFrom(""),
+		Age:  null.IntFrom(0),
+		IDs:  []null.Int64{null.Int64From(1), null.Int64From(2)},
+	}
+
+	for _, policy := range []NullPolicy{NullIfMissing, NullIfEmptyOrMissing} {
+		values, err := Encode(filter, WithNullPolicy(policy))
+		require.NoError(t, err)
+
+		var decoded Filter
+		err = Decode(values, &decoded, WithNullPolicy(policy))
+		require.NoError(t, err)
+		assert.Equal(t, filter.Age, decoded.Age)
+		assert.Equal(t, filter.IDs, decoded.IDs)
+	}
+
+	values, err := Encode(filter, WithNullPolicy(NullIfMissing))
+	require.NoError(t, err)
+
+	var decoded Filter
+	err = Decode(values, &decoded, WithNullPolicy(NullIfMissing))
+	require.NoError(t, err)
+	assert.Equal(t, filter.Name, decoded.Name)
+}
+
+func TestEncodeErrors(t *testing.T) {
+	_, err := Encode("x")
+	require.ErrorIs(t, err, ErrNotStruct)
+
+	type unsupported struct {
+		Values map[string]int `form:"values"`
+		Name   null.String    `form:"name"`
+	}
+
+	values, err := Encode(unsupported{Name: null.StringFrom("John")})
+	require.ErrorIs(t, err, ErrUnsupportedType)
+	require.ErrorIs(t, err, null.ErrCannotMarshal)
+
+	var fieldError FieldError
+	require.ErrorAs(t, err, &fieldError)
+	assert.Equal(t, "values", fieldError.Field)
+	assert.Equal(t, url.Values{"name": {"John"}}, values)
+}
@@ -0,0 +1,35 @@
+package nullform // import "github.com/Patrick-Batenburg/nullify/nullform"
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Patrick-Batenburg/nullify/internal/structfield"
+)
+
+var (
+	ErrInvalidDestination = errors.New(
+		"nullform: destination must be a non-nil pointer to a struct",
+	)
+	ErrNotStruct       = errors.New("nullform: source must be a struct or a pointer to a struct")
+	ErrUnsupportedType = fmt.Errorf("nullform: %w", structfield.ErrUnsupportedType)
+)
+
+// FieldError represents an error that occurs while decoding or encoding a single field.
+// It wraps a null.UnmarshalError or null.MarshalError.
+type FieldError struct {
+	// Field is the form key of the field.
+	Field string
+
+	err error
+}
+
+// Error returns the string representation of the FieldError.
+func (e FieldError) Error() string {
+	return fmt.Sprintf("nullform: field %s: %v", e.Field, e.err)
+}
+
+// Unwrap returns the underlying error for unwrapping.
+func (e FieldError) Unwrap() error {
+	return e.err
+}
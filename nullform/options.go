@@ -0,0 +1,66 @@
+package nullform // import "github.com/Patrick-Batenburg/nullify/nullform"
+
+import (
+	"github.com/Patrick-Batenburg/nullify/null"
+)
+
+// NullPolicy determines whether an empty value, a missing key or both represent null.
+type NullPolicy int
+
+const (
+	// NullIfEmpty decodes empty values as null and leaves the fields of missing keys unchanged.
+	// Invalid fields are encoded as empty values. This is the default.
+	NullIfEmpty NullPolicy = iota
+
+	// NullIfMissing decodes missing keys as null and empty values as values,
+	// such as a valid empty null.String. Invalid fields are omitted when encoding.
+	NullIfMissing
+
+	// NullIfEmptyOrMissing decodes both empty values and missing keys as null.
+	// Invalid fields are omitted when encoding.
+	NullIfEmptyOrMissing
+)
+
+// options holds the configuration of Decode and Encode.
+type options struct {
+	policy NullPolicy
+	config *null.Config
+}
+
+// OptionFn is a type alias for a function that modifies the options of Decode and Encode.
+type OptionFn = func(*options)
+
+// newOptions returns the default options with opts applied.
+func newOptions(opts ...OptionFn) options {
+	var option options
+
+	for _, opt := range opts {
+		opt(&option)
+	}
+
+	return option
+}
+
+// WithNullPolicy sets the NullPolicy. The default policy is NullIfEmpty.
+func WithNullPolicy(policy NullPolicy) OptionFn {
+	return func(option *options) {
+		option.policy = policy
+	}
+}
+
+// WithConfig binds config to the nullable fields of the destination before decoding. It is ignored by Encode, see null.Config.Apply.
+func WithConfig(config null.Config) OptionFn {
+	return func(option *options) {
+		option.config = &config
+	}
+}
+
+// emptyIsNull reports whether an empty value is decoded as null.
+func (o options) emptyIsNull() bool {
+	return o.policy != NullIfMissing
+}
+
+// missingIsNull reports whether a missing key is decoded as null.
+func (o options) missingIsNull() bool {
+	return o.policy != NullIfEmpty
+}